@@ -0,0 +1,83 @@
+// Command verifyproof verifies a transaction proof returned by the GetTransactionProof RPC without contacting Vault.
+//
+// The proof is the document_proof of the response saved to a file. It is verified against the trusted state
+// of the ledger, e.g. the state of a previously verified proof, without a trusted state the proof must start
+// from the first transaction of the ledger. The proven transaction and the proven state of the ledger are printed
+// as JSON, the command fails if the proof doesn't verify or the transaction doesn't match the expected one:
+//
+//	go run ./cmd/verifyproof -proof proof.json -id <transaction id> -trusted-tx-id 42 -trusted-tx-hash <hex> -expect transaction.json
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go"
+	"log"
+	"os"
+)
+
+type result struct {
+	Transaction TransactionRecord `json:"transaction"`
+	State       state             `json:"state"`
+}
+
+type state struct {
+	Db     string `json:"db"`
+	TxId   uint64 `json:"tx_id"`
+	TxHash string `json:"tx_hash"`
+}
+
+func main() {
+	proofPath := flag.String("proof", "", "file with the document proof in JSON")
+	id := flag.String("id", "", "id of the transaction")
+	trustedTxId := flag.Uint64("trusted-tx-id", 0, "id of the transaction of the trusted ledger state")
+	trustedTxHash := flag.String("trusted-tx-hash", "", "hash of the trusted ledger state in hex")
+	expectPath := flag.String("expect", "", "file with the expected transaction in JSON as the command prints it, optional")
+	flag.Parse()
+
+	if *proofPath == "" || *id == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	documentProof, err := os.ReadFile(*proofPath)
+	if err != nil {
+		log.Fatalf("failed to read the proof: %v", err)
+	}
+	trusted := LedgerState{TxId: *trustedTxId}
+	if trusted.TxHash, err = hex.DecodeString(*trustedTxHash); err != nil {
+		log.Fatalf("bad trusted state hash: %v", err)
+	}
+
+	transaction, proven, err := VerifyTransactionProof(documentProof, *id, trusted)
+	if err != nil {
+		log.Fatalf("proof doesn't verify: %v", err)
+	}
+	if *expectPath != "" {
+		b, err := os.ReadFile(*expectPath)
+		if err != nil {
+			log.Fatalf("failed to read the expected transaction: %v", err)
+		}
+		var expected TransactionRecord
+		if err := json.Unmarshal(b, &expected); err != nil {
+			log.Fatalf("bad expected transaction: %v", err)
+		}
+		same, err := SameDocument(expected, transaction)
+		if err != nil {
+			log.Fatalf("failed to compare the transactions: %v", err)
+		}
+		if !same {
+			log.Fatalf("transaction in the ledger doesn't match the expected one: %+v", transaction)
+		}
+	}
+
+	out := json.NewEncoder(os.Stdout)
+	out.SetIndent("", "  ")
+	err = out.Encode(result{
+		Transaction: transaction,
+		State:       state{Db: proven.Db, TxId: proven.TxId, TxHash: hex.EncodeToString(proven.TxHash)},
+	})
+	if err != nil {
+		log.Fatalf("failed to write the result: %v", err)
+	}
+}
@@ -1,7 +1,11 @@
 package main
 
 import (
+	"context"
 	"embed"
+	"errors"
+	"flag"
+	"fmt"
 	"github.com/MadAppGang/httplog"
 	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go"
 	"github.com/kelseyhightower/envconfig"
@@ -29,8 +33,13 @@ func main() {
 		return
 	}
 
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		runImport(conf, os.Args[2:])
+		return
+	}
+
 	// create grpc servers
-	grpcServer, grpcWebServer, err := GetGrpcServers(conf.GrpcServersConfig)
+	grpcServer, grpcWebServer, statementServer, err := GetGrpcServers(conf.GrpcServersConfig)
 	if err != nil {
 		log.Fatalf("failed to start grpc servers: %v", err)
 	}
@@ -39,13 +48,15 @@ func main() {
 	buildDir, _ := fs.Sub(webStaticEmbed, "build")
 	webFrontServer := FileServer(FS(buildDir))
 
-	// create a handler that will route requests to the grpc servers or the web app
+	// create a handler that will route requests to the grpc servers, the statement downloads or the web app
 	handler := HandlerFunc(func(w ResponseWriter, r *Request) {
 		switch {
 		case r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc"):
 			grpcServer.ServeHTTP(w, r)
 		case grpcWebServer.IsAcceptableGrpcCorsRequest(r) || grpcWebServer.IsGrpcWebRequest(r):
 			grpcWebServer.ServeHTTP(w, r)
+		case r.URL.Path == "/statements":
+			statementServer.ServeHTTP(w, r)
 		default:
 			webFrontServer.ServeHTTP(w, r)
 		}
@@ -59,6 +70,80 @@ func main() {
 	}
 }
 
+// runImport loads a CSV file of accounts or transactions into the configured storage:
+//
+//	go run ./server.go import -kind accounts -map number=Account,name=Customer -dry-run accounts.csv
+func runImport(conf *Config, args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	kind := flags.String("kind", "", "kind of records in the file: accounts or transactions")
+	mapping := flags.String("map", "", "columns of the fields as field=column pairs separated by commas, "+
+		"the fields not mapped are read from the columns of the same name")
+	fromLine := flags.Int("from-line", 0, "line to resume the import from, the header is line 1")
+	dryRun := flags.Bool("dry-run", false, "check all the records and report the failing ones without writing anything")
+	batchSize := flags.Int("batch-size", 100, "most transactions written at once")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: %s import [flags] file.csv\n", os.Args[0])
+		fmt.Fprintln(flags.Output(), "The records are written to the storage directly: the withdrawals aren't checked "+
+			"against the overdraft limits and the running servers don't notify the watchers of the transactions. "+
+			"The records imported before are skipped, so a failed import can be fixed and run again "+
+			"from the start or from a line.")
+		flags.PrintDefaults()
+	}
+	_ = flags.Parse(args)
+	if flags.NArg() != 1 || *kind == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+	columns := map[string]string{}
+	if *mapping != "" {
+		for _, pair := range strings.Split(*mapping, ",") {
+			field, column, ok := strings.Cut(pair, "=")
+			if !ok {
+				log.Fatalf("bad column mapping %q, expected field=column", pair)
+			}
+			columns[strings.TrimSpace(field)] = strings.TrimSpace(column)
+		}
+	}
+
+	file, err := os.Open(flags.Arg(0))
+	if err != nil {
+		log.Fatalf("failed to open the file: %v", err)
+	}
+	defer file.Close()
+	storage, err := NewStorage(conf.GrpcServersConfig)
+	if err != nil {
+		log.Fatalf("failed to start storage: %v", err)
+	}
+	if err := storage.InitCollections(context.Background()); err != nil {
+		log.Fatalf("failed to init collections: %v", err)
+	}
+
+	report, err := ImportCSV(context.Background(), storage, file, ImportOptions{
+		Kind:            *kind,
+		Columns:         columns,
+		FromLine:        *fromLine,
+		DryRun:          *dryRun,
+		DefaultCurrency: conf.GrpcServersConfig.DefaultCurrency,
+		BatchSize:       *batchSize,
+		Log:             os.Stderr,
+	})
+	if *dryRun {
+		log.Printf("dry run: %d records valid, %d duplicates, %d failing", report.Imported, report.Duplicates, report.Failed)
+	} else {
+		log.Printf("%d records imported, %d duplicates skipped", report.Imported, report.Duplicates)
+	}
+	var importErr *ImportError
+	if errors.As(err, &importErr) {
+		log.Fatalf("import stopped at %v, fix the record and resume with -from-line %d", importErr, importErr.Line)
+	}
+	if err != nil {
+		log.Fatalf("import failed: %v", err)
+	}
+	if report.Failed > 0 {
+		os.Exit(1)
+	}
+}
+
 func getEnv(key string, defaultVal string) string {
 	if value, exists := os.LookupEnv(key); exists {
 		return value
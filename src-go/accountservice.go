@@ -2,75 +2,345 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
 	pb "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/proto"
+	"github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultproof"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 )
 
+type AccountServiceConfig struct {
+	// DefaultCurrency is used for accounts created without a currency
+	// and for accounts created before currencies were introduced
+	DefaultCurrency string `default:"EUR"`
+	// WatchPollInterval is how often Vault is polled for the transactions written by other instances
+	// while anybody watches the transactions, 0 disables the polling
+	WatchPollInterval time.Duration `default:"2s"`
+	// MaxBatchSize is the most transactions CreateTransactions creates at once
+	MaxBatchSize int `default:"1000"`
+	// PageTokenKey signs the page tokens, the instances serving the same clients must share it. A random key
+	// is used if it's empty, the tokens are only valid on the instance that issued them then
+	PageTokenKey string
+}
+
 type AccountService struct {
-	vaultStorage *VaultStorage
+	storage Storage
+	config  AccountServiceConfig
+	// checksMu serializes the balance and reversal checks with the writes that depend on them within this instance,
+	// so the withdrawals of the instance don't conflict with each other. The withdrawals of the other instances are
+	// told apart by the storage, see withdraw
+	checksMu sync.Mutex
+	hub      *transactionHub
+	// pageTokenKey signs the page tokens, see encodePageToken
+	pageTokenKey []byte
 	pb.UnimplementedAccountServiceServer
 }
 
+// NewAccountService creates the account service on top of the `storage`
+func NewAccountService(storage Storage, config AccountServiceConfig) (*AccountService, error) {
+	if _, ok := CurrencyExponent(config.DefaultCurrency); !ok {
+		return nil, fmt.Errorf("unknown default currency %q", config.DefaultCurrency)
+	}
+	pageTokenKey := []byte(config.PageTokenKey)
+	if len(pageTokenKey) == 0 {
+		pageTokenKey = make([]byte, 32)
+		_, _ = rand.Read(pageTokenKey)
+	}
+	return &AccountService{
+		storage:      storage,
+		config:       config,
+		hub:          newTransactionHub(storage, config.WatchPollInterval),
+		pageTokenKey: pageTokenKey,
+	}, nil
+}
+
 func (s *AccountService) ListAccounts(ctx context.Context, in *pb.ListAccountsRequest) (*pb.ListAccountsResponse, error) {
-	accounts, count, err := s.vaultStorage.ListAccounts(
-		ctx, int(in.PageSize), int(in.PageNumber),
-	)
+	query, err := listQueryFromPb(in.Filters, in.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := s.pageCursor(in.PageToken, in.PageSize, in.PageNumber, listingHash("accounts", "", query))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	var accounts []AccountRecord
+	var count int
+	var next *Cursor
+	if cursor != nil {
+		accounts, count, next, err = s.listAccountsFrom(ctx, query, *cursor)
+	} else {
+		accounts, count, err = s.storage.ListAccounts(ctx, query, int(in.PageSize), int(in.PageNumber))
+	}
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error listing accounts: %w", err)
 	}
+	numbers := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		numbers = append(numbers, account.Number)
+	}
+	balances, err := s.storage.GetBalances(ctx, numbers)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balances: %w", err)
+	}
 	var pbAccounts []*pb.Account
 	for _, account := range accounts {
-		pbAccounts = append(pbAccounts, &pb.Account{
-			Id:      account.Id,
-			Number:  account.Number,
-			Name:    account.Name,
-			Address: account.Address,
-			Iban:    account.IBAN,
-		})
+		pbAccounts = append(pbAccounts, s.accountToPb(account, balances[account.Number]))
 	}
-	return &pb.ListAccountsResponse{
+	resp := &pb.ListAccountsResponse{
 		PageSize:   in.PageSize,
 		PageNumber: in.PageNumber,
 		TotalCount: int32(count),
 		Accounts:   pbAccounts,
-	}, nil
+	}
+	if cursor != nil {
+		resp.PageSize, resp.PageNumber = int32(cursor.PageSize), int32(cursor.PageNumber)
+		resp.NextPageToken = encodePageToken(s.pageTokenKey, next)
+	}
+	return resp, nil
+}
+
+// listAccountsFrom reads the page the cursor points at from an open search if the storage keeps searches open,
+// otherwise the page is read by its number
+func (s *AccountService) listAccountsFrom(ctx context.Context, query ListQuery, cursor Cursor) ([]AccountRecord, int, *Cursor, error) {
+	if storage, ok := s.storage.(CursorStorage); ok {
+		return storage.ListAccountsFrom(ctx, query, cursor)
+	}
+	accounts, count, err := s.storage.ListAccounts(ctx, query, cursor.PageSize, cursor.PageNumber)
+	return accounts, count, cursor.numberedNext(count), err
+}
+
+func (s *AccountService) GetAccount(ctx context.Context, in *pb.GetAccountRequest) (*pb.GetAccountResponse, error) {
+	var account AccountRecord
+	var err error
+	switch selector := in.Selector.(type) {
+	case *pb.GetAccountRequest_Id:
+		if !isDocumentId(selector.Id) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid account id %q", selector.Id)
+		}
+		account, err = s.storage.GetAccountById(ctx, selector.Id)
+	case *pb.GetAccountRequest_Number:
+		if selector.Number == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "account number is empty")
+		}
+		account, err = s.storage.GetAccount(ctx, selector.Number)
+	case *pb.GetAccountRequest_Iban:
+		if selector.Iban == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "IBAN is empty")
+		}
+		account, err = s.storage.GetAccountByIBAN(ctx, selector.Iban)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "id, number or IBAN of the account is required")
+	}
+	if errors.Is(err, NotFoundError) {
+		return nil, status.Errorf(codes.NotFound, "account not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting account: %w", err)
+	}
+
+	balance, err := s.storage.GetBalance(ctx, account.Number)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance of account %s: %w", account.Number, err)
+	}
+	return &pb.GetAccountResponse{Account: s.accountToPb(account, balance)}, nil
 }
 
 func (s *AccountService) ListTransactions(ctx context.Context, in *pb.ListTransactionsRequest) (*pb.ListTransactionsResponse, error) {
-	transactions, count, err := s.vaultStorage.ListTransactions(
-		ctx, in.AccountNumber, int(in.PageSize), int(in.PageNumber),
-	)
+	query, err := listQueryFromPb(in.Filters, in.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := s.pageCursor(in.PageToken, in.PageSize, in.PageNumber, listingHash("transactions", in.AccountNumber, query))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	var transactions []TransactionRecord
+	var count int
+	var next *Cursor
+	if cursor != nil {
+		transactions, count, next, err = s.listTransactionsFrom(ctx, in.AccountNumber, query, *cursor)
+	} else {
+		transactions, count, err = s.storage.ListTransactions(
+			ctx, in.AccountNumber, query, int(in.PageSize), int(in.PageNumber),
+		)
+	}
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error listing transactions: %w", err)
 	}
-	var pbTransactions []*pb.Transaction
-	for _, transaction := range transactions {
-		pbTransactions = append(pbTransactions, &pb.Transaction{
-			Id:            transaction.Id,
-			AccountNumber: transaction.AccountNumber,
-			Amount:        transaction.Amount,
-			Type:          pb.TransactionType(pb.TransactionType_value[transaction.Type]),
-		})
+	pbTransactions, err := s.transactionsToPb(ctx, transactions)
+	if err != nil {
+		return nil, err
 	}
 
-	return &pb.ListTransactionsResponse{
+	resp := &pb.ListTransactionsResponse{
 		PageSize:     in.PageSize,
 		PageNumber:   in.PageNumber,
 		TotalCount:   int32(count),
 		Transactions: pbTransactions,
-	}, nil
+	}
+	if cursor != nil {
+		resp.PageSize, resp.PageNumber = int32(cursor.PageSize), int32(cursor.PageNumber)
+		resp.NextPageToken = encodePageToken(s.pageTokenKey, next)
+	}
+	return resp, nil
 }
 
-func (s *AccountService) CreateAccount(ctx context.Context, in *pb.Account) (*pb.CreateAccountResponse, error) {
-	id, err := s.vaultStorage.AddAccount(ctx, AccountRecord{
-		Number:  in.Number,
-		Name:    in.Name,
-		Address: in.Address,
-		IBAN:    in.Iban,
+// listTransactionsFrom is listAccountsFrom for the transactions of the account
+func (s *AccountService) listTransactionsFrom(ctx context.Context, accountNumber string, query ListQuery, cursor Cursor) ([]TransactionRecord, int, *Cursor, error) {
+	if storage, ok := s.storage.(CursorStorage); ok {
+		return storage.ListTransactionsFrom(ctx, accountNumber, query, cursor)
+	}
+	transactions, count, err := s.storage.ListTransactions(ctx, accountNumber, query, cursor.PageSize, cursor.PageNumber)
+	return transactions, count, cursor.numberedNext(count), err
+}
+
+// transactionsPageSize is the page size the transactions are read with when all of them are needed,
+// the maximum of Vault
+const transactionsPageSize = vaultMaxPerPage
+
+// eachTransactionsPage calls `fn` with every page of the transactions of the account matching the query until
+// `fn` fails or the context is done. The pages are read with the same search as ListTransactions, so they all
+// come from one snapshot if the storage keeps searches open, and only one page is held at a time
+func (s *AccountService) eachTransactionsPage(ctx context.Context, accountNumber string, query ListQuery, fn func([]TransactionRecord) error) error {
+	cursor := &Cursor{PageNumber: 1, PageSize: transactionsPageSize}
+	for cursor != nil {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		transactions, _, next, err := s.listTransactionsFrom(ctx, accountNumber, query, *cursor)
+		if err != nil {
+			return err
+		}
+		if err := fn(transactions); err != nil {
+			return err
+		}
+		cursor = next
+	}
+	return nil
+}
+
+// pageCursor returns the cursor of the page of the listing requested by the token, the first page is requested
+// by leaving both the token and the page number unset. Nil is returned if the page is requested by its number.
+// InvalidInputError is returned if the token wasn't issued by the service or was issued for another listing
+func (s *AccountService) pageCursor(pageToken string, pageSize int32, pageNumber int32, listing string) (*Cursor, error) {
+	if pageToken != "" {
+		cursor, err := decodePageToken(s.pageTokenKey, pageToken)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.Listing != listing {
+			return nil, fmt.Errorf("%w: page token was issued for other filters, order or account, "+
+				"they can't change between the pages", InvalidInputError)
+		}
+		return &cursor, nil
+	}
+	if pageNumber != 0 {
+		return nil, nil
+	}
+	return &Cursor{PageNumber: 1, PageSize: int(pageSize), Listing: listing}, nil
+}
+
+func (s *AccountService) ExportTransactions(in *pb.ExportTransactionsRequest, stream pb.AccountService_ExportTransactionsServer) error {
+	ctx := stream.Context()
+	query, err := listQueryFromPb(in.Filters, in.OrderBy)
+	if err != nil {
+		return err
+	}
+	if _, err := s.getAccount(ctx, in.AccountNumber); err != nil {
+		return err
+	}
+	err = s.eachTransactionsPage(ctx, in.AccountNumber, query, func(transactions []TransactionRecord) error {
+		pbTransactions, err := s.transactionsToPb(ctx, transactions)
+		if err != nil {
+			return err
+		}
+		for _, transaction := range pbTransactions {
+			if err := stream.Send(transaction); err != nil {
+				return err
+			}
+		}
+		return nil
 	})
+	if errors.Is(err, InvalidInputError) {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+	if err != nil {
+		return fmt.Errorf("error exporting transactions: %w", err)
+	}
+	return nil
+}
+
+func (s *AccountService) WatchTransactions(in *pb.WatchTransactionsRequest, stream pb.AccountService_WatchTransactionsServer) error {
+	ctx := stream.Context()
+	if in.AccountNumber != "" {
+		if _, err := s.getAccount(ctx, in.AccountNumber); err != nil {
+			return err
+		}
+	}
+	w := s.hub.subscribe(in.AccountNumber)
+	defer s.hub.unsubscribe(w)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case transaction, ok := <-w.transactions:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted, "watcher fell behind the new transactions, watch again")
+			}
+			if err := stream.Send(s.transactionToPb(transaction)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *AccountService) CreateAccount(ctx context.Context, in *pb.Account) (*pb.CreateAccountResponse, error) {
+	key, err := idempotencyKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account := AccountRecord{
+		Number:         in.Number,
+		Name:           in.Name,
+		Address:        in.Address,
+		IBAN:           in.Iban,
+		OverdraftLimit: in.OverdraftLimit,
+		Currency:       s.currencyOrDefault(in.Currency),
+		IdempotencyKey: key,
+	}
+	if key != "" {
+		if id, err := s.replayedAccount(ctx, account); err != nil || id != "" {
+			return &pb.CreateAccountResponse{Id: id}, err
+		}
+	}
+
+	id, err := s.storage.AddAccount(ctx, account)
+	if errors.Is(err, DuplicateKeyError) && key != "" {
+		// a concurrent request with the same key may have won
+		if id, err := s.replayedAccount(ctx, account); err != nil || id != "" {
+			return &pb.CreateAccountResponse{Id: id}, err
+		}
+	}
 	if errors.Is(err, DuplicateKeyError) {
 		return nil, status.Errorf(codes.AlreadyExists, "`Account Number` already exists")
 	}
@@ -83,17 +353,917 @@ func (s *AccountService) CreateAccount(ctx context.Context, in *pb.Account) (*pb
 	return &pb.CreateAccountResponse{Id: id}, nil
 }
 
+// replayedAccount returns the id of the account created with the idempotency key of the account, the id is empty
+// if the key wasn't used yet. FailedPrecondition is returned if the key was used by a different request
+func (s *AccountService) replayedAccount(ctx context.Context, account AccountRecord) (string, error) {
+	existing, err := s.storage.GetAccountByIdempotencyKey(ctx, account.IdempotencyKey)
+	if errors.Is(err, NotFoundError) {
+		return "", nil
+	}
+	if errors.Is(err, OutdatedCollectionError) {
+		return "", status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, InvalidInputError) {
+		return "", status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return "", fmt.Errorf("error getting account by idempotency key: %w", err)
+	}
+	if !existing.SameRequest(account) {
+		return "", status.Errorf(codes.FailedPrecondition,
+			"idempotency key %q was used to create a different account", account.IdempotencyKey)
+	}
+	return existing.Id, nil
+}
+
+// UpdateAccount changes the fields of the update mask, or the fields set in the request without one.
+// The number, the currency and the overdraft limit of the account are kept
+func (s *AccountService) UpdateAccount(ctx context.Context, in *pb.UpdateAccountRequest) (*pb.UpdateAccountResponse, error) {
+	actor, err := requestActor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	paths := in.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		for path, value := range map[string]string{"name": in.Name, "address": in.Address, "iban": in.Iban} {
+			if value != "" {
+				paths = append(paths, path)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "nothing to update, set the fields to change or the update mask")
+	}
+	account, err := s.getAccount(ctx, in.Number)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		switch path {
+		case "name":
+			account.Name = in.Name
+		case "address":
+			account.Address = in.Address
+		case "iban":
+			account.IBAN = in.Iban
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "field %q can't be updated, the fields are name, address and iban", path)
+		}
+	}
+	account.Actor = actor
+	// accounts created before currencies get the default one written down
+	account.Currency = s.currencyOrDefault(account.Currency)
+
+	err = s.storage.UpdateAccount(ctx, account)
+	if errors.Is(err, NotFoundError) {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", in.Number)
+	}
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error updating account: %w", err)
+	}
+	balance, err := s.storage.GetBalance(ctx, account.Number)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance of account %s: %w", account.Number, err)
+	}
+	return &pb.UpdateAccountResponse{Account: s.accountToPb(account, balance)}, nil
+}
+
+func (s *AccountService) GetAccountHistory(ctx context.Context, in *pb.GetAccountHistoryRequest) (*pb.GetAccountHistoryResponse, error) {
+	historyStorage, ok := s.storage.(HistoryStorage)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "account history is only available with the Vault storage")
+	}
+	if in.AccountNumber == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "account number is empty")
+	}
+	history, err := historyStorage.GetAccountHistory(ctx, in.AccountNumber)
+	if errors.Is(err, NotFoundError) {
+		return nil, status.Errorf(codes.NotFound, "account %s not found", in.AccountNumber)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting account history: %w", err)
+	}
+
+	resp := &pb.GetAccountHistoryResponse{}
+	for _, rev := range history.Revisions {
+		resp.Revisions = append(resp.Revisions, &pb.AccountRevision{
+			Revision:      rev.Revision,
+			TransactionId: rev.TransactionId,
+			Account:       s.accountToPb(rev.Account, 0),
+			Creator:       rev.Creator,
+			Ts:            rev.Ts,
+			Actor:         rev.Account.Actor,
+		})
+	}
+	for _, diff := range history.Diffs {
+		pbDiff, err := structpb.NewStruct(diff.Diff)
+		if err != nil {
+			return nil, fmt.Errorf("error converting diff %s: %w", diff.DiffIds, err)
+		}
+		resp.Diffs = append(resp.Diffs, &pb.AccountDiff{DiffIds: diff.DiffIds, Diff: pbDiff})
+	}
+	return resp, nil
+}
+
 func (s *AccountService) CreateTransaction(ctx context.Context, in *pb.Transaction) (*pb.CreateTransactionResponse, error) {
-	id, err := s.vaultStorage.AddTransaction(ctx, TransactionRecord{
-		AccountNumber: in.AccountNumber,
-		Amount:        in.Amount,
-		Type:          in.Type.String(),
-	})
+	key, err := idempotencyKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := s.getAccount(ctx, in.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := s.newTransaction(account, in, FormatTimestamp(time.Now()), key)
+	if err != nil {
+		return nil, err
+	}
+	// a replayed withdrawal returns the recorded one even if the balance doesn't allow it anymore
+	if key != "" {
+		if id, err := s.replayedTransaction(ctx, transaction); err != nil || id != "" {
+			return &pb.CreateTransactionResponse{Id: id}, err
+		}
+	}
+
+	var id, replayed string
+	add := func() error {
+		id, err = s.storage.AddTransaction(ctx, transaction)
+		if errors.Is(err, DuplicateKeyError) && key != "" {
+			// a concurrent request with the same key may have won, otherwise the sequence was taken
+			if replayed, err = s.replayedTransaction(ctx, transaction); err == nil && replayed == "" {
+				err = DuplicateKeyError
+			}
+		}
+		return err
+	}
+	if transaction.Type == WithdrawalType {
+		s.checksMu.Lock()
+		defer s.checksMu.Unlock()
+
+		err = s.withdraw(ctx, []string{account.Number}, func(states map[string]BalanceState) error {
+			state := states[account.Number]
+			if err := checkFunds(account, state.Balance, transaction.Amount); err != nil {
+				return err
+			}
+			transaction.Sequence = state.Sequence + 1
+			return add()
+		})
+	} else {
+		err = add()
+	}
+	if replayed != "" {
+		return &pb.CreateTransactionResponse{Id: replayed}, nil
+	}
+	if errors.Is(err, DuplicateKeyError) {
+		return nil, status.Errorf(codes.AlreadyExists, "transaction already exists")
+	}
 	if errors.Is(err, InvalidInputError) {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
 	}
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
 	if err != nil {
 		return nil, fmt.Errorf("error creating transaction: %w", err)
 	}
+	transaction.Id = id
+	s.hub.publish(transaction)
 	return &pb.CreateTransactionResponse{Id: id}, nil
 }
+
+// CreateTransactions creates the transactions in a single write. The transactions are checked one by one as in
+// CreateTransaction and the ones that fail are reported in their results, the others are created unless
+// the request is all or nothing. Idempotency keys aren't supported, the request is rejected if it has one
+func (s *AccountService) CreateTransactions(ctx context.Context, in *pb.CreateTransactionsRequest) (*pb.CreateTransactionsResponse, error) {
+	if len(in.Transactions) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "no transactions to create")
+	}
+	if len(in.Transactions) > s.config.MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"at most %d transactions can be created at once, got %d", s.config.MaxBatchSize, len(in.Transactions),
+		)
+	}
+	if keys := metadata.ValueFromIncomingContext(ctx, IdempotencyKeyHeader); len(keys) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency keys aren't supported by CreateTransactions")
+	}
+
+	results := make([]*pb.CreateTransactionsResult, len(in.Transactions))
+	// failure is the result of a transaction that failed, the errors other than gRPC statuses fail the whole request
+	failure := func(err error) (*pb.CreateTransactionsResult, error) {
+		st, ok := status.FromError(err)
+		if !ok {
+			return nil, err
+		}
+		return &pb.CreateTransactionsResult{Code: int32(st.Code()), Error: st.Message()}, nil
+	}
+
+	accounts := map[string]AccountRecord{}
+	accountErrors := map[string]error{}
+	transactions := make([]TransactionRecord, len(in.Transactions))
+	createdAt := FormatTimestamp(time.Now())
+	for i, t := range in.Transactions {
+		account, ok := accounts[t.AccountNumber]
+		err := accountErrors[t.AccountNumber]
+		if !ok && err == nil {
+			account, err = s.getAccount(ctx, t.AccountNumber)
+			if err == nil {
+				accounts[t.AccountNumber] = account
+			} else {
+				accountErrors[t.AccountNumber] = err
+			}
+		}
+		if err == nil {
+			transactions[i], err = s.newTransaction(account, t, createdAt, "")
+		}
+		if err != nil {
+			if results[i], err = failure(err); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	s.checksMu.Lock()
+	defer s.checksMu.Unlock()
+
+	// the withdrawals are checked against the balance with the transactions before them in the request
+	// and numbered one after another
+	var withdrawing []string
+	for i, t := range transactions {
+		if results[i] == nil && t.Type == WithdrawalType && !slices.Contains(withdrawing, t.AccountNumber) {
+			withdrawing = append(withdrawing, t.AccountNumber)
+		}
+	}
+	var checked []*pb.CreateTransactionsResult
+	var written []TransactionRecord
+	var ids []string
+	write := func(states map[string]BalanceState) error {
+		checked = slices.Clone(results)
+		written = nil
+		failed := slices.ContainsFunc(checked, func(r *pb.CreateTransactionsResult) bool { return r != nil })
+		var valid []TransactionRecord
+		for i, t := range transactions {
+			if checked[i] != nil {
+				continue
+			}
+			if state, ok := states[t.AccountNumber]; ok {
+				if t.Type == WithdrawalType {
+					if err := checkFunds(accounts[t.AccountNumber], state.Balance, t.Amount); err != nil {
+						checked[i], _ = failure(err)
+						failed = true
+						continue
+					}
+					state.Sequence++
+					t.Sequence = state.Sequence
+				}
+				state.Balance += t.SignedAmount()
+				states[t.AccountNumber] = state
+			}
+			valid = append(valid, t)
+		}
+		if failed && in.AllOrNothing || len(valid) == 0 {
+			return nil
+		}
+		var err error
+		if ids, err = s.storage.AddTransactions(ctx, valid); err == nil {
+			written = valid
+		}
+		return err
+	}
+	var err error
+	if len(withdrawing) > 0 {
+		err = s.withdraw(ctx, withdrawing, write)
+	} else {
+		err = write(nil)
+	}
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating transactions: %w", err)
+	}
+
+	if len(written) == 0 {
+		for i := range checked {
+			if checked[i] == nil {
+				checked[i] = &pb.CreateTransactionsResult{
+					Code:  int32(codes.Aborted),
+					Error: "not created, other transactions of the request failed",
+				}
+			}
+		}
+		return &pb.CreateTransactionsResponse{Results: checked}, nil
+	}
+	for i := range written {
+		written[i].Id = ids[i]
+	}
+	s.hub.publish(written...)
+	for i := range checked {
+		if checked[i] == nil {
+			checked[i] = &pb.CreateTransactionsResult{Id: ids[0]}
+			ids = ids[1:]
+		}
+	}
+	return &pb.CreateTransactionsResponse{Results: checked}, nil
+}
+
+// newTransaction makes the record of the transaction to create on the account, the errors are gRPC statuses
+func (s *AccountService) newTransaction(account AccountRecord, in *pb.Transaction, createdAt string, key string) (TransactionRecord, error) {
+	currency, err := s.transactionCurrency(account, in.Currency)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+	transaction := TransactionRecord{
+		AccountNumber:  in.AccountNumber,
+		Amount:         in.Amount,
+		Type:           in.Type.String(),
+		Currency:       currency,
+		CreatedAt:      createdAt,
+		ValueDate:      in.ValueDate,
+		Description:    in.Description,
+		Reference:      in.Reference,
+		Metadata:       in.Metadata,
+		IdempotencyKey: key,
+	}
+	if err := transaction.Validate(); err != nil {
+		return TransactionRecord{}, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return transaction, nil
+}
+
+// replayedTransaction is replayedAccount for the transactions
+func (s *AccountService) replayedTransaction(ctx context.Context, transaction TransactionRecord) (string, error) {
+	existing, err := s.storage.GetTransactionByIdempotencyKey(ctx, transaction.IdempotencyKey)
+	if errors.Is(err, NotFoundError) {
+		return "", nil
+	}
+	if errors.Is(err, OutdatedCollectionError) {
+		return "", status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, InvalidInputError) {
+		return "", status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return "", fmt.Errorf("error getting transaction by idempotency key: %w", err)
+	}
+	if !existing.SameRequest(transaction) {
+		return "", status.Errorf(codes.FailedPrecondition,
+			"idempotency key %q was used to create a different transaction", transaction.IdempotencyKey)
+	}
+	return existing.Id, nil
+}
+
+// maxWithdrawAttempts is how many times withdraw checks the withdrawals again after the balances changed
+const maxWithdrawAttempts = 5
+
+// withdraw checks and writes withdrawals from the accounts. `write` gets the balances of the accounts and the
+// sequences of their last withdrawals and writes the withdrawals checked against the balances with the next
+// sequences. The storages keep the sequences unique per account, so if the balance was changed meanwhile by a
+// withdrawal of another instance, the write fails with DuplicateKeyError and is checked against the new balance
+func (s *AccountService) withdraw(ctx context.Context, accountNumbers []string, write func(map[string]BalanceState) error) error {
+	for attempt := 0; attempt < maxWithdrawAttempts; attempt++ {
+		states, err := s.storage.GetBalanceStates(ctx, accountNumbers)
+		if err != nil {
+			return fmt.Errorf("error getting balances: %w", err)
+		}
+		if err := write(states); !errors.Is(err, DuplicateKeyError) {
+			return err
+		}
+	}
+	return status.Errorf(codes.Aborted, "accounts %s are withdrawn from concurrently, try again",
+		strings.Join(accountNumbers, ", "),
+	)
+}
+
+// checkFunds returns FailedPrecondition if withdrawing the amount would take the balance below the overdraft limit
+func checkFunds(account AccountRecord, balance int64, amount int64) error {
+	if balance-amount < -account.OverdraftLimit {
+		return status.Errorf(codes.FailedPrecondition,
+			"insufficient funds on account %s: balance %d, overdraft limit %d", account.Number, balance, account.OverdraftLimit,
+		)
+	}
+	return nil
+}
+
+func (s *AccountService) GetAccountBalance(ctx context.Context, in *pb.GetAccountBalanceRequest) (*pb.GetAccountBalanceResponse, error) {
+	account, err := s.getAccount(ctx, in.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := s.storage.GetBalance(ctx, in.AccountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance: %w", err)
+	}
+	currency := s.currencyOrDefault(account.Currency)
+	exponent, _ := CurrencyExponent(currency)
+	return &pb.GetAccountBalanceResponse{
+		AccountNumber:    in.AccountNumber,
+		Balance:          balance,
+		Currency:         currency,
+		CurrencyExponent: int32(exponent),
+	}, nil
+}
+
+func (s *AccountService) GetStatement(ctx context.Context, in *pb.GetStatementRequest) (*pb.GetStatementResponse, error) {
+	account, err := s.getAccount(ctx, in.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := s.statement(ctx, account, in.FromDate, in.ToDate)
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error building statement: %w", err)
+	}
+
+	transactions := make([]TransactionRecord, 0, len(statement.Lines))
+	for _, line := range statement.Lines {
+		transactions = append(transactions, line.Transaction)
+	}
+	pbTransactions, err := s.transactionsToPb(ctx, transactions)
+	if err != nil {
+		return nil, err
+	}
+	lines := make([]*pb.StatementLine, 0, len(statement.Lines))
+	for i, line := range statement.Lines {
+		lines = append(lines, &pb.StatementLine{Transaction: pbTransactions[i], Balance: line.Balance})
+	}
+	currency := s.currencyOrDefault(account.Currency)
+	exponent, _ := CurrencyExponent(currency)
+	return &pb.GetStatementResponse{
+		AccountNumber:    account.Number,
+		Currency:         currency,
+		CurrencyExponent: int32(exponent),
+		FromDate:         statement.From,
+		ToDate:           statement.To,
+		OpeningBalance:   statement.OpeningBalance,
+		Lines:            lines,
+		TotalDeposits:    statement.TotalDeposits,
+		TotalWithdrawals: statement.TotalWithdrawals,
+		ClosingBalance:   statement.ClosingBalance,
+	}, nil
+}
+
+func (s *AccountService) ExportStatement(ctx context.Context, in *pb.ExportStatementRequest) (*pb.ExportStatementResponse, error) {
+	if in.FromDate == "" || in.ToDate == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "both from_date and to_date are required")
+	}
+	account, err := s.getAccount(ctx, in.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	statement, err := s.statement(ctx, account, in.FromDate, in.ToDate)
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error building statement: %w", err)
+	}
+
+	currency := s.currencyOrDefault(account.Currency)
+	response := &pb.ExportStatementResponse{}
+	switch in.Format {
+	case pb.StatementFormat_CAMT_053:
+		response.Content, err = RenderCamt053(statement, currency, time.Now())
+		response.ContentType = "application/xml"
+		response.FileName = statementId(statement) + ".xml"
+	case pb.StatementFormat_MT940:
+		response.Content, err = RenderMT940(statement, currency)
+		response.ContentType = "text/plain"
+		response.FileName = statementId(statement) + ".sta"
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown statement format %v", in.Format)
+	}
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (s *AccountService) Transfer(ctx context.Context, in *pb.TransferRequest) (*pb.TransferResponse, error) {
+	from, err := s.getAccount(ctx, in.FromAccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.getAccount(ctx, in.ToAccountNumber)
+	if err != nil {
+		return nil, err
+	}
+	currency, err := s.transactionCurrency(from, in.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.transactionCurrency(to, currency); err != nil {
+		return nil, err
+	}
+
+	transferId := newDocumentId()
+	createdAt := FormatTimestamp(time.Now())
+	legs := []TransactionRecord{
+		{
+			AccountNumber:             in.FromAccountNumber,
+			Amount:                    in.Amount,
+			Type:                      WithdrawalType,
+			Currency:                  currency,
+			TransferId:                transferId,
+			CounterpartyAccountNumber: in.ToAccountNumber,
+			CreatedAt:                 createdAt,
+			ValueDate:                 in.ValueDate,
+			Description:               in.Description,
+			Reference:                 in.Reference,
+			Metadata:                  in.Metadata,
+		},
+		{
+			AccountNumber:             in.ToAccountNumber,
+			Amount:                    in.Amount,
+			Type:                      DepositType,
+			Currency:                  currency,
+			TransferId:                transferId,
+			CounterpartyAccountNumber: in.FromAccountNumber,
+			CreatedAt:                 createdAt,
+			ValueDate:                 in.ValueDate,
+			Description:               in.Description,
+			Reference:                 in.Reference,
+			Metadata:                  in.Metadata,
+		},
+	}
+	for _, leg := range legs {
+		if err := leg.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	s.checksMu.Lock()
+	defer s.checksMu.Unlock()
+
+	// the debit leg is numbered as the other withdrawals of the account
+	var ids []string
+	err = s.withdraw(ctx, []string{from.Number}, func(states map[string]BalanceState) error {
+		state := states[from.Number]
+		if err := checkFunds(from, state.Balance, in.Amount); err != nil {
+			return err
+		}
+		legs[0].Sequence = state.Sequence + 1
+		ids, err = s.storage.AddTransactions(ctx, legs)
+		return err
+	})
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating transfer: %w", err)
+	}
+	for i := range legs {
+		legs[i].Id = ids[i]
+	}
+	s.hub.publish(legs...)
+	return &pb.TransferResponse{
+		TransferId:   transferId,
+		WithdrawalId: ids[0],
+		DepositId:    ids[1],
+	}, nil
+}
+
+func (s *AccountService) ReverseTransaction(ctx context.Context, in *pb.ReverseTransactionRequest) (*pb.ReverseTransactionResponse, error) {
+	if !isDocumentId(in.TransactionId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction id %q", in.TransactionId)
+	}
+	original, err := s.storage.GetTransaction(ctx, in.TransactionId)
+	if errors.Is(err, NotFoundError) {
+		return nil, status.Errorf(codes.NotFound, "transaction %s not found", in.TransactionId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting transaction: %w", err)
+	}
+	if original.ReversedTransactionId != "" {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"transaction %s is a reversal of %s and can't be reversed", original.Id, original.ReversedTransactionId,
+		)
+	}
+
+	// a transfer is reversed as a whole, the requested leg goes first
+	originals := []TransactionRecord{original}
+	transferId := ""
+	if original.TransferId != "" {
+		legs, err := s.storage.GetTransferTransactions(ctx, original.TransferId)
+		if err != nil {
+			return nil, fmt.Errorf("error getting transfer: %w", err)
+		}
+		for _, leg := range legs {
+			if leg.Id != original.Id {
+				originals = append(originals, leg)
+			}
+		}
+		transferId = newDocumentId()
+	}
+
+	ids := make([]string, 0, len(originals))
+	reversals := make([]TransactionRecord, 0, len(originals))
+	createdAt := FormatTimestamp(time.Now())
+	for _, t := range originals {
+		ids = append(ids, t.Id)
+		reversal := t.Reversal()
+		reversal.Currency = s.currencyOrDefault(t.Currency)
+		reversal.TransferId = transferId
+		reversal.CreatedAt = createdAt
+		reversals = append(reversals, reversal)
+	}
+
+	// the reversals of deposits are withdrawals
+	accounts := map[string]AccountRecord{}
+	var withdrawing []string
+	for _, reversal := range reversals {
+		if reversal.Type != WithdrawalType {
+			continue
+		}
+		account, err := s.getAccount(ctx, reversal.AccountNumber)
+		if err != nil {
+			return nil, err
+		}
+		accounts[account.Number] = account
+		withdrawing = append(withdrawing, account.Number)
+	}
+
+	s.checksMu.Lock()
+	defer s.checksMu.Unlock()
+
+	var reversalIds []string
+	err = s.withdraw(ctx, withdrawing, func(states map[string]BalanceState) error {
+		// the storage rejects a second reversal written meanwhile by the idempotency key, see Reversal,
+		// it is found here on the next attempt
+		existing, err := s.storage.GetReversals(ctx, ids)
+		if err != nil {
+			return fmt.Errorf("error getting reversals: %w", err)
+		}
+		if len(existing) > 0 {
+			return status.Errorf(codes.FailedPrecondition,
+				"transaction %s is already reversed by %s", existing[0].ReversedTransactionId, existing[0].Id,
+			)
+		}
+		for i, reversal := range reversals {
+			if reversal.Type != WithdrawalType {
+				continue
+			}
+			state := states[reversal.AccountNumber]
+			if err := checkFunds(accounts[reversal.AccountNumber], state.Balance, reversal.Amount); err != nil {
+				return err
+			}
+			reversals[i].Sequence = state.Sequence + 1
+			states[reversal.AccountNumber] = BalanceState{Balance: state.Balance - reversal.Amount, Sequence: state.Sequence + 1}
+		}
+		reversalIds, err = s.storage.AddTransactions(ctx, reversals)
+		return err
+	})
+	if errors.Is(err, OutdatedCollectionError) {
+		return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, InvalidInputError) {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating reversal: %w", err)
+	}
+	for i := range reversals {
+		reversals[i].Id = reversalIds[i]
+	}
+	s.hub.publish(reversals...)
+	return &pb.ReverseTransactionResponse{
+		Id:         reversalIds[0],
+		TransferId: transferId,
+	}, nil
+}
+
+func (s *AccountService) GetTransactionProof(ctx context.Context, in *pb.GetTransactionProofRequest) (*pb.GetTransactionProofResponse, error) {
+	proofStorage, ok := s.storage.(ProofStorage)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "proofs are only available with the Vault storage")
+	}
+	if !isDocumentId(in.TransactionId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid transaction id %q", in.TransactionId)
+	}
+	transaction, err := s.storage.GetTransaction(ctx, in.TransactionId)
+	if errors.Is(err, NotFoundError) {
+		return nil, status.Errorf(codes.NotFound, "transaction %s not found", in.TransactionId)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting transaction: %w", err)
+	}
+
+	proof, err := proofStorage.GetTransactionProof(ctx, in.TransactionId)
+	if errors.Is(err, NotFoundError) {
+		return nil, status.Errorf(codes.NotFound, "transaction %s not found", in.TransactionId)
+	}
+	if errors.Is(err, LedgerUnavailableError) || errors.Is(err, vaultproof.ErrMalformedProof) {
+		return nil, status.Errorf(codes.Unavailable, "error getting transaction proof: %v", err)
+	}
+	if errors.Is(err, vaultproof.ErrInvalidProof) {
+		return nil, status.Errorf(codes.DataLoss, err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error getting transaction proof: %w", err)
+	}
+	// the transaction must be served as it is in the ledger
+	same, err := SameDocument(proof.Transaction, transaction)
+	if err != nil {
+		return nil, err
+	}
+	if !same {
+		return nil, status.Errorf(codes.DataLoss, "transaction %s doesn't match its document in the ledger", in.TransactionId)
+	}
+	return &pb.GetTransactionProofResponse{
+		Transaction:   s.transactionToPb(proof.Transaction),
+		DocumentProof: proof.DocumentProof,
+		TrustedState:  ledgerStateToPb(proof.TrustedState),
+		State:         ledgerStateToPb(proof.State),
+	}, nil
+}
+
+// IdempotencyKeyHeader is the gRPC metadata key of the idempotency key of CreateAccount and CreateTransaction
+const IdempotencyKeyHeader = "idempotency-key"
+
+// idempotencyKey returns the idempotency key the client sent with the request, it is empty if none was sent
+func idempotencyKey(ctx context.Context) (string, error) {
+	keys := metadata.ValueFromIncomingContext(ctx, IdempotencyKeyHeader)
+	switch {
+	case len(keys) == 0:
+		return "", nil
+	case len(keys) > 1:
+		return "", status.Errorf(codes.InvalidArgument, "only one idempotency key may be sent")
+	case keys[0] == "":
+		return "", status.Errorf(codes.InvalidArgument, "idempotency key is empty")
+	case len(keys[0]) > maxIdempotencyKeyLength:
+		return "", status.Errorf(codes.InvalidArgument, "idempotency key is longer than %d bytes", maxIdempotencyKeyLength)
+	}
+	return keys[0], nil
+}
+
+// ActorHeader is the gRPC metadata key of who makes the change in UpdateAccount
+const ActorHeader = "actor"
+
+// requestActor returns the actor the client sent with the request
+func requestActor(ctx context.Context) (string, error) {
+	actors := metadata.ValueFromIncomingContext(ctx, ActorHeader)
+	switch {
+	case len(actors) == 0 || actors[0] == "":
+		return "", status.Errorf(codes.InvalidArgument, "the %s metadata is required", ActorHeader)
+	case len(actors) > 1:
+		return "", status.Errorf(codes.InvalidArgument, "only one actor may be sent")
+	case len(actors[0]) > maxActorLength:
+		return "", status.Errorf(codes.InvalidArgument, "actor is longer than %d bytes", maxActorLength)
+	}
+	return actors[0], nil
+}
+
+// getAccount returns the account or a gRPC status error if there is no such account
+func (s *AccountService) getAccount(ctx context.Context, number string) (AccountRecord, error) {
+	if number == "" {
+		return AccountRecord{}, status.Errorf(codes.InvalidArgument, "account number is empty")
+	}
+	account, err := s.storage.GetAccount(ctx, number)
+	if errors.Is(err, NotFoundError) {
+		return AccountRecord{}, status.Errorf(codes.NotFound, "account %s not found", number)
+	}
+	if err != nil {
+		return AccountRecord{}, fmt.Errorf("error getting account: %w", err)
+	}
+	return account, nil
+}
+
+// currencyOrDefault returns the default currency for accounts and transactions created before currencies
+func (s *AccountService) currencyOrDefault(currency string) string {
+	if currency == "" {
+		return s.config.DefaultCurrency
+	}
+	return currency
+}
+
+// transactionCurrency returns the currency of a new transaction on the account,
+// FailedPrecondition is returned if the requested currency doesn't match the account currency
+func (s *AccountService) transactionCurrency(account AccountRecord, requested string) (string, error) {
+	currency := s.currencyOrDefault(account.Currency)
+	if requested != "" && requested != currency {
+		return "", status.Errorf(codes.FailedPrecondition,
+			"currency %s doesn't match currency %s of account %s", requested, currency, account.Number,
+		)
+	}
+	return currency, nil
+}
+
+func (s *AccountService) accountToPb(account AccountRecord, balance int64) *pb.Account {
+	currency := s.currencyOrDefault(account.Currency)
+	exponent, _ := CurrencyExponent(currency)
+	return &pb.Account{
+		Id:               account.Id,
+		Number:           account.Number,
+		Name:             account.Name,
+		Address:          account.Address,
+		Iban:             account.IBAN,
+		Balance:          balance,
+		OverdraftLimit:   account.OverdraftLimit,
+		Currency:         currency,
+		CurrencyExponent: int32(exponent),
+	}
+}
+
+func (s *AccountService) transactionToPb(transaction TransactionRecord) *pb.Transaction {
+	return &pb.Transaction{
+		Id:                        transaction.Id,
+		AccountNumber:             transaction.AccountNumber,
+		Amount:                    transaction.Amount,
+		Type:                      pb.TransactionType(pb.TransactionType_value[transaction.Type]),
+		CounterpartyAccountNumber: transaction.CounterpartyAccountNumber,
+		TransferId:                transaction.TransferId,
+		Currency:                  s.currencyOrDefault(transaction.Currency),
+		ReversedTransactionId:     transaction.ReversedTransactionId,
+		CreatedAt:                 timestampToPb(transaction.CreatedAt),
+		ValueDate:                 transaction.ValueDate,
+		Description:               transaction.Description,
+		Reference:                 transaction.Reference,
+		Metadata:                  transaction.Metadata,
+	}
+}
+
+// transactionsToPb converts the transactions along with the ids of their reversals
+func (s *AccountService) transactionsToPb(ctx context.Context, transactions []TransactionRecord) ([]*pb.Transaction, error) {
+	ids := make([]string, 0, len(transactions))
+	for _, transaction := range transactions {
+		ids = append(ids, transaction.Id)
+	}
+	reversals, err := s.storage.GetReversals(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("error getting reversals: %w", err)
+	}
+	reversalIds := map[string]string{}
+	for _, reversal := range reversals {
+		reversalIds[reversal.ReversedTransactionId] = reversal.Id
+	}
+
+	var pbTransactions []*pb.Transaction
+	for _, transaction := range transactions {
+		pbTransaction := s.transactionToPb(transaction)
+		pbTransaction.ReversalId = reversalIds[transaction.Id]
+		pbTransactions = append(pbTransactions, pbTransaction)
+	}
+	return pbTransactions, nil
+}
+
+// timestampToPb converts a timestamp in TimestampLayout, nil is returned for empty timestamps
+func timestampToPb(timestamp string) *timestamppb.Timestamp {
+	t, err := time.Parse(TimestampLayout, timestamp)
+	if err != nil {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// listQueryFromPb converts the filters and the order of a list request, the fields are checked by the storage.
+// Numbers are passed as int64 as all numeric fields are integers
+func listQueryFromPb(filters []*pb.FieldFilter, orderBy []*pb.SortField) (ListQuery, error) {
+	var query ListQuery
+	for _, f := range filters {
+		var value any
+		switch v := f.Value.GetKind().(type) {
+		case *structpb.Value_StringValue:
+			value = v.StringValue
+		case *structpb.Value_NumberValue:
+			if v.NumberValue != math.Trunc(v.NumberValue) || math.Abs(v.NumberValue) > 1<<53 {
+				return ListQuery{}, status.Errorf(codes.InvalidArgument, "value of %s must be an integer", f.Field)
+			}
+			value = int64(v.NumberValue)
+		default:
+			return ListQuery{}, status.Errorf(codes.InvalidArgument, "value of %s must be a string or a number", f.Field)
+		}
+		query.Filters = append(query.Filters, Filter{
+			Field:    f.Field,
+			Operator: FilterOperator(f.Operator.String()),
+			Value:    value,
+		})
+	}
+	for _, s := range orderBy {
+		query.OrderBy = append(query.OrderBy, SortField{Field: s.Field, Desc: s.Desc})
+	}
+	return query, nil
+}
+
+func ledgerStateToPb(state LedgerState) *pb.LedgerState {
+	return &pb.LedgerState{
+		Db:     state.Db,
+		TxId:   state.TxId,
+		TxHash: state.TxHash,
+	}
+}
@@ -0,0 +1,701 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	pb "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+// newTestService returns the service on the storage with the accounts 1001 with the overdraft limit of 500
+// and 1002 without an overdraft
+func newTestService(t *testing.T, backend testStorage) *AccountService {
+	t.Helper()
+	service, err := NewAccountService(openTestStorage(t, backend), AccountServiceConfig{DefaultCurrency: "EUR", MaxBatchSize: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	for _, account := range []*pb.Account{
+		{Number: "1001", Name: "Alice", OverdraftLimit: 500},
+		{Number: "1002", Name: "Bob"},
+	} {
+		if _, err := service.CreateAccount(ctx, account); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return service
+}
+
+func expectCode(t *testing.T, err error, code codes.Code) {
+	t.Helper()
+	if status.Code(err) != code {
+		t.Fatalf("expected %s, got %v", code, err)
+	}
+}
+
+func expectBalances(t *testing.T, service *AccountService, balances map[string]int64) {
+	t.Helper()
+	for number, expected := range balances {
+		r, err := service.GetAccountBalance(context.Background(), &pb.GetAccountBalanceRequest{AccountNumber: number})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Balance != expected {
+			t.Fatalf("balance of %s: got %d, expected %d", number, r.Balance, expected)
+		}
+	}
+}
+
+func TestAccountServiceOverdraft(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			service := newTestService(t, backend)
+			if _, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 1000}); err != nil {
+				t.Fatal(err)
+			}
+			// the balance may go down to minus the overdraft limit, not further
+			withdraw := &pb.Transaction{AccountNumber: "1001", Amount: 1500, Type: pb.TransactionType_WITHDRAWAL}
+			if _, err := service.CreateTransaction(ctx, withdraw); err != nil {
+				t.Fatal(err)
+			}
+			withdraw.Amount = 1
+			_, err := service.CreateTransaction(ctx, withdraw)
+			expectCode(t, err, codes.FailedPrecondition)
+			_, err = service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1002", Amount: 1, Type: pb.TransactionType_WITHDRAWAL})
+			expectCode(t, err, codes.FailedPrecondition)
+			expectBalances(t, service, map[string]int64{"1001": -500, "1002": 0})
+		})
+	}
+}
+
+// racingStorage calls race after reading the balances, the next `races` times
+type racingStorage struct {
+	Storage
+	races int
+	race  func()
+}
+
+func (r *racingStorage) GetBalanceStates(ctx context.Context, accountNumbers []string) (map[string]BalanceState, error) {
+	states, err := r.Storage.GetBalanceStates(ctx, accountNumbers)
+	if r.races > 0 {
+		r.races--
+		r.race()
+	}
+	return states, err
+}
+
+func TestAccountServiceConcurrentInstances(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			// the other instance withdraws from the account after the balance is read
+			other := newTestService(t, backend)
+			storage := &racingStorage{Storage: other.storage}
+			service, err := NewAccountService(storage, AccountServiceConfig{DefaultCurrency: "EUR", MaxBatchSize: 1000})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 1000}); err != nil {
+				t.Fatal(err)
+			}
+			withdraw := func(service *AccountService, amount int64) error {
+				_, err := service.CreateTransaction(ctx, &pb.Transaction{
+					AccountNumber: "1001", Amount: amount, Type: pb.TransactionType_WITHDRAWAL,
+				})
+				return err
+			}
+			var racing int64
+			storage.race = func() {
+				if err := withdraw(other, racing); err != nil {
+					t.Error(err)
+				}
+			}
+
+			// the withdrawal is checked again against the balance after the other one
+			storage.races, racing = 1, 1000
+			expectCode(t, withdraw(service, 1000), codes.FailedPrecondition)
+			expectBalances(t, service, map[string]int64{"1001": 0})
+			storage.races, racing = 1, 100
+			if err := withdraw(service, 100); err != nil {
+				t.Fatal(err)
+			}
+			expectBalances(t, service, map[string]int64{"1001": -200})
+			// the request is given up if the balance keeps changing
+			storage.races, racing = maxWithdrawAttempts, 10
+			expectCode(t, withdraw(service, 10), codes.Aborted)
+			expectBalances(t, service, map[string]int64{"1001": -200 - 10*maxWithdrawAttempts})
+
+			// so is the debit of a transfer
+			storage.races, racing = 1, 250
+			_, err = service.Transfer(ctx, &pb.TransferRequest{FromAccountNumber: "1001", ToAccountNumber: "1002", Amount: 1})
+			expectCode(t, err, codes.FailedPrecondition)
+			expectBalances(t, service, map[string]int64{"1001": -500, "1002": 0})
+			// and of a reversal of a deposit
+			deposit, err := other.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 600})
+			if err != nil {
+				t.Fatal(err)
+			}
+			storage.races, racing = 1, 1
+			_, err = service.ReverseTransaction(ctx, &pb.ReverseTransactionRequest{TransactionId: deposit.Id})
+			expectCode(t, err, codes.FailedPrecondition)
+			expectBalances(t, service, map[string]int64{"1001": 99})
+			// and of the withdrawals of a batch, the last one doesn't fit anymore
+			storage.races, racing = 1, 100
+			batch, err := service.CreateTransactions(ctx, &pb.CreateTransactionsRequest{Transactions: []*pb.Transaction{
+				{AccountNumber: "1001", Amount: 300, Type: pb.TransactionType_WITHDRAWAL},
+				{AccountNumber: "1001", Amount: 200, Type: pb.TransactionType_WITHDRAWAL},
+			}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectResults(t, batch, codes.OK, codes.FailedPrecondition)
+			expectBalances(t, service, map[string]int64{"1001": -301})
+		})
+	}
+}
+
+// expectResults checks the codes of the results of CreateTransactions, the created transactions have ids
+func expectResults(t *testing.T, response *pb.CreateTransactionsResponse, expected ...codes.Code) {
+	t.Helper()
+	var got []codes.Code
+	for _, result := range response.Results {
+		got = append(got, codes.Code(result.Code))
+		if (result.Code == int32(codes.OK)) != (result.Id != "") {
+			t.Errorf("result %+v", result)
+		}
+	}
+	if !slices.Equal(got, expected) {
+		t.Fatalf("result codes %v, expected %v: %+v", got, expected, response.Results)
+	}
+}
+
+func TestAccountServiceCreateTransactions(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			service := newTestService(t, backend)
+			create := func(allOrNothing bool, transactions ...*pb.Transaction) *pb.CreateTransactionsResponse {
+				t.Helper()
+				response, err := service.CreateTransactions(ctx, &pb.CreateTransactionsRequest{
+					Transactions: transactions, AllOrNothing: allOrNothing,
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return response
+			}
+			withdrawal := func(accountNumber string, amount int64) *pb.Transaction {
+				return &pb.Transaction{AccountNumber: accountNumber, Amount: amount, Type: pb.TransactionType_WITHDRAWAL}
+			}
+
+			// the transactions that fail are reported, the others are created
+			response := create(false,
+				&pb.Transaction{AccountNumber: "1002", Amount: 100},
+				&pb.Transaction{AccountNumber: "9999", Amount: 100},
+				&pb.Transaction{AccountNumber: "1002"},
+				&pb.Transaction{AccountNumber: "1002", Amount: 100, Currency: "USD"},
+				withdrawal("1002", 50),
+			)
+			expectResults(t, response, codes.OK, codes.NotFound, codes.InvalidArgument, codes.FailedPrecondition, codes.OK)
+			expectBalances(t, service, map[string]int64{"1002": 50})
+			for _, i := range []int{0, 4} {
+				if _, err := service.storage.GetTransaction(ctx, response.Results[i].Id); err != nil {
+					t.Fatalf("created transaction %d: %v", i, err)
+				}
+			}
+
+			// the withdrawals are checked against the balance with the transactions before them
+			response = create(false, withdrawal("1002", 30), withdrawal("1002", 30), &pb.Transaction{AccountNumber: "1002", Amount: 40}, withdrawal("1002", 50))
+			expectResults(t, response, codes.OK, codes.FailedPrecondition, codes.OK, codes.OK)
+			expectBalances(t, service, map[string]int64{"1002": 10})
+
+			// a single failure leaves the ledger untouched in all or nothing mode
+			response = create(true, &pb.Transaction{AccountNumber: "1001", Amount: 10}, withdrawal("1001", 100), withdrawal("1002", 11))
+			expectResults(t, response, codes.Aborted, codes.Aborted, codes.FailedPrecondition)
+			expectBalances(t, service, map[string]int64{"1001": 0, "1002": 10})
+			response = create(true, &pb.Transaction{AccountNumber: "1001", Amount: 10}, withdrawal("1001", 100), withdrawal("1002", 10))
+			expectResults(t, response, codes.OK, codes.OK, codes.OK)
+			expectBalances(t, service, map[string]int64{"1001": -90, "1002": 0})
+
+			// the size of the batch is limited
+			_, err := service.CreateTransactions(ctx, &pb.CreateTransactionsRequest{})
+			expectCode(t, err, codes.InvalidArgument)
+			batch := make([]*pb.Transaction, service.config.MaxBatchSize+1)
+			for i := range batch {
+				batch[i] = &pb.Transaction{AccountNumber: "1001", Amount: 1}
+			}
+			_, err = service.CreateTransactions(ctx, &pb.CreateTransactionsRequest{Transactions: batch})
+			expectCode(t, err, codes.InvalidArgument)
+			_, err = service.CreateTransactions(ctx, &pb.CreateTransactionsRequest{Transactions: batch[1:]})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expectBalances(t, service, map[string]int64{"1001": -90 + int64(service.config.MaxBatchSize)})
+
+			// idempotency keys aren't supported
+			keyed := metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyHeader, "batch"))
+			_, err = service.CreateTransactions(keyed, &pb.CreateTransactionsRequest{Transactions: batch[:1]})
+			expectCode(t, err, codes.InvalidArgument)
+		})
+	}
+}
+
+func TestAccountServiceTransfer(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			service := newTestService(t, backend)
+			if _, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 300}); err != nil {
+				t.Fatal(err)
+			}
+			r, err := service.Transfer(ctx, &pb.TransferRequest{FromAccountNumber: "1001", ToAccountNumber: "1002", Amount: 700})
+			if err != nil {
+				t.Fatal(err)
+			}
+			legs, err := service.storage.GetTransferTransactions(ctx, r.TransferId)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(legs) != 2 {
+				t.Fatalf("expected both legs of the transfer, got %+v", legs)
+			}
+
+			_, err = service.Transfer(ctx, &pb.TransferRequest{FromAccountNumber: "1001", ToAccountNumber: "1002", Amount: 101})
+			expectCode(t, err, codes.FailedPrecondition)
+			_, err = service.Transfer(ctx, &pb.TransferRequest{FromAccountNumber: "1001", ToAccountNumber: "1001", Amount: 1})
+			expectCode(t, err, codes.InvalidArgument)
+			_, err = service.Transfer(ctx, &pb.TransferRequest{FromAccountNumber: "1001", ToAccountNumber: "1003", Amount: 1})
+			expectCode(t, err, codes.NotFound)
+			expectBalances(t, service, map[string]int64{"1001": -400, "1002": 700})
+		})
+	}
+}
+
+func TestAccountServiceReversal(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			service := newTestService(t, backend)
+			deposit, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 1000})
+			if err != nil {
+				t.Fatal(err)
+			}
+			transfer, err := service.Transfer(ctx, &pb.TransferRequest{FromAccountNumber: "1001", ToAccountNumber: "1002", Amount: 400})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// reversing either leg reverses the whole transfer
+			reversal, err := service.ReverseTransaction(ctx, &pb.ReverseTransactionRequest{TransactionId: transfer.DepositId})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if reversal.TransferId == "" {
+				t.Fatal("reversal of a transfer isn't a transfer")
+			}
+			expectBalances(t, service, map[string]int64{"1001": 1000, "1002": 0})
+			_, err = service.ReverseTransaction(ctx, &pb.ReverseTransactionRequest{TransactionId: transfer.WithdrawalId})
+			expectCode(t, err, codes.FailedPrecondition)
+			_, err = service.ReverseTransaction(ctx, &pb.ReverseTransactionRequest{TransactionId: reversal.Id})
+			expectCode(t, err, codes.FailedPrecondition)
+			// the storage rejects a second reversal that got past the check, e.g. made by another instance
+			withdrawal, err := service.storage.GetTransaction(ctx, transfer.WithdrawalId)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := service.storage.AddTransactions(ctx, []TransactionRecord{withdrawal.Reversal()}); !errors.Is(err, DuplicateKeyError) {
+				t.Fatalf("expected %v writing the reversal again, got %v", DuplicateKeyError, err)
+			}
+
+			// the reversal of a deposit is a withdrawal and respects the overdraft limit
+			if _, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 1400, Type: pb.TransactionType_WITHDRAWAL}); err != nil {
+				t.Fatal(err)
+			}
+			_, err = service.ReverseTransaction(ctx, &pb.ReverseTransactionRequest{TransactionId: deposit.Id})
+			expectCode(t, err, codes.FailedPrecondition)
+			expectBalances(t, service, map[string]int64{"1001": -400, "1002": 0})
+		})
+	}
+}
+
+func TestAccountServiceIdempotency(t *testing.T) {
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			service := newTestService(t, backend)
+			withKey := func(key string) context.Context {
+				return metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyHeader, key))
+			}
+
+			account := &pb.Account{Number: "1003", Name: "Carol"}
+			created, err := service.CreateAccount(withKey("account-1"), account)
+			if err != nil {
+				t.Fatal(err)
+			}
+			replayed, err := service.CreateAccount(withKey("account-1"), account)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if replayed.Id != created.Id {
+				t.Fatalf("replayed account %s, expected %s", replayed.Id, created.Id)
+			}
+			_, err = service.CreateAccount(withKey("account-1"), &pb.Account{Number: "1004", Name: "Dave"})
+			expectCode(t, err, codes.FailedPrecondition)
+			// the account changed since is still replayed
+			actor := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "carol"))
+			if _, err := service.UpdateAccount(actor, &pb.UpdateAccountRequest{Number: "1003", Name: "Carol Smith"}); err != nil {
+				t.Fatal(err)
+			}
+			replayed, err = service.CreateAccount(withKey("account-1"), account)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if replayed.Id != created.Id {
+				t.Fatalf("replayed updated account %s, expected %s", replayed.Id, created.Id)
+			}
+
+			withdrawal := &pb.Transaction{AccountNumber: "1001", Amount: 500, Type: pb.TransactionType_WITHDRAWAL}
+			first, err := service.CreateTransaction(withKey("withdrawal-1"), withdrawal)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the replay returns the recorded withdrawal although the balance doesn't allow another one
+			second, err := service.CreateTransaction(withKey("withdrawal-1"), withdrawal)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if second.Id != first.Id {
+				t.Fatalf("replayed transaction %s, expected %s", second.Id, first.Id)
+			}
+			_, err = service.CreateTransaction(withKey("withdrawal-1"), &pb.Transaction{AccountNumber: "1001", Amount: 1})
+			expectCode(t, err, codes.FailedPrecondition)
+			_, err = service.CreateTransaction(withKey(""), withdrawal)
+			expectCode(t, err, codes.InvalidArgument)
+			expectBalances(t, service, map[string]int64{"1001": -500})
+		})
+	}
+}
+
+func TestAccountServiceDuplicateTransaction(t *testing.T) {
+	ctx := context.Background()
+	storage, fake := newFakeVaultStorage(t)
+	if err := storage.InitCollections(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := storage.AddAccount(ctx, testAccount("1001")); err != nil {
+		t.Fatal(err)
+	}
+	service, err := NewAccountService(storage, AccountServiceConfig{DefaultCurrency: "EUR", MaxBatchSize: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a conflict of a request without a key isn't a replay
+	fake.FailNext("DocumentCreate", http.StatusConflict)
+	_, err = service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 1})
+	expectCode(t, err, codes.AlreadyExists)
+}
+
+func TestAccountServiceUpdateAccount(t *testing.T) {
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			service := newTestService(t, backend)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(ActorHeader, "alice"))
+			update := func(in *pb.UpdateAccountRequest) *pb.Account {
+				t.Helper()
+				r, err := service.UpdateAccount(ctx, in)
+				if err != nil {
+					t.Fatal(err)
+				}
+				return r.Account
+			}
+
+			_, err := service.UpdateAccount(context.Background(), &pb.UpdateAccountRequest{Number: "1001", Name: "Alice B."})
+			expectCode(t, err, codes.InvalidArgument)
+
+			// the fields left empty are kept
+			account := update(&pb.UpdateAccountRequest{Number: "1001", Address: "Main St 1", Iban: "DE1001"})
+			if account.Name != "Alice" || account.Address != "Main St 1" || account.Iban != "DE1001" || account.OverdraftLimit != 500 {
+				t.Fatalf("updated account %+v", account)
+			}
+			// the mask clears the fields
+			account = update(&pb.UpdateAccountRequest{Number: "1001", Name: "Alice B.", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "iban"}}})
+			if account.Name != "Alice B." || account.Address != "Main St 1" || account.Iban != "" {
+				t.Fatalf("updated account %+v", account)
+			}
+			stored, err := service.storage.GetAccount(ctx, "1001")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stored.Actor != "alice" {
+				t.Fatalf("account updated by %q, expected alice", stored.Actor)
+			}
+
+			for _, in := range []*pb.UpdateAccountRequest{
+				{Number: "1001"},
+				{Number: "1001", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"name"}}},
+				{Number: "1001", Name: "Alice", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"overdraft_limit"}}},
+			} {
+				_, err = service.UpdateAccount(ctx, in)
+				expectCode(t, err, codes.InvalidArgument)
+			}
+			_, err = service.UpdateAccount(ctx, &pb.UpdateAccountRequest{Number: "1003", Name: "Carol"})
+			expectCode(t, err, codes.NotFound)
+		})
+	}
+}
+
+func TestAccountServicePageTokens(t *testing.T) {
+	ctx := context.Background()
+	storage, fake := newFakeVaultStorage(t)
+	if err := storage.InitCollections(ctx); err != nil {
+		t.Fatal(err)
+	}
+	service, err := NewAccountService(storage, AccountServiceConfig{DefaultCurrency: "EUR", MaxBatchSize: 1000})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, number := range []string{"1001", "1002", "1003", "1004"} {
+		if _, err := service.CreateAccount(ctx, &pb.Account{Number: number, Name: "Account " + number}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: number, Amount: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the last page is full, the search is closed nevertheless
+	first, err := service.ListAccounts(ctx, &pb.ListAccountsRequest{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	last, err := service.ListAccounts(ctx, &pb.ListAccountsRequest{PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(last.Accounts) != 2 || last.NextPageToken != "" {
+		t.Fatalf("last page %+v", last)
+	}
+	if open := fake.OpenSearches(); open != 0 {
+		t.Fatalf("%d searches are left open", open)
+	}
+
+	// the token only pages through the listing it was issued for
+	first, err = service.ListAccounts(ctx, &pb.ListAccountsRequest{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = service.ListAccounts(ctx, &pb.ListAccountsRequest{
+		PageToken: first.NextPageToken, OrderBy: []*pb.SortField{{Field: "number", Desc: true}},
+	})
+	expectCode(t, err, codes.InvalidArgument)
+	_, err = service.ListTransactions(ctx, &pb.ListTransactionsRequest{AccountNumber: "1001", PageToken: first.NextPageToken})
+	expectCode(t, err, codes.InvalidArgument)
+	if _, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 1}); err != nil {
+		t.Fatal(err)
+	}
+	transactions, err := service.ListTransactions(ctx, &pb.ListTransactionsRequest{AccountNumber: "1001", PageSize: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = service.ListTransactions(ctx, &pb.ListTransactionsRequest{AccountNumber: "1002", PageToken: transactions.NextPageToken})
+	expectCode(t, err, codes.InvalidArgument)
+	_, err = service.ListAccounts(ctx, &pb.ListAccountsRequest{PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the tokens are signed, a changed cursor or a token signed with another key is rejected
+	first, err = service.ListAccounts(ctx, &pb.ListAccountsRequest{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := base64.RawURLEncoding.DecodeString(first.NextPageToken)
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := base64.RawURLEncoding.EncodeToString(bytes.Replace(data, []byte(`"n":2`), []byte(`"n":3`), 1))
+	_, err = service.ListAccounts(ctx, &pb.ListAccountsRequest{PageToken: forged})
+	expectCode(t, err, codes.InvalidArgument)
+	config := AccountServiceConfig{DefaultCurrency: "EUR", MaxBatchSize: 1000, PageTokenKey: "key"}
+	other, err := NewAccountService(storage, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = other.ListAccounts(ctx, &pb.ListAccountsRequest{PageToken: first.NextPageToken})
+	expectCode(t, err, codes.InvalidArgument)
+	// the instances sharing the key take the tokens of each other
+	sharing, err := NewAccountService(storage, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err = other.ListAccounts(ctx, &pb.ListAccountsRequest{PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	last, err = sharing.ListAccounts(ctx, &pb.ListAccountsRequest{PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(last.Accounts) != 2 {
+		t.Fatalf("last page %+v", last)
+	}
+}
+
+func TestAccountServiceStatementWithoutCreatedAt(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			service := newTestService(t, backend)
+			// a transaction written before the transactions had created_at, Vault has no such field in the document
+			if vault, ok := service.storage.(*VaultStorage); ok {
+				r, err := vault.client.DocumentCreateWithResponse(ctx, "default", "transactions", map[string]any{
+					"account_number": "1001", "amount": 100, "type": DepositType, "currency": "EUR", "idempotency_key": "legacy",
+				})
+				if err != nil || r.StatusCode() != 200 {
+					t.Fatalf("can't write the transaction: %v %s", err, r.Body)
+				}
+			} else {
+				undated := testTransaction("1001", DepositType, 100)
+				undated.CreatedAt = ""
+				if _, err := service.storage.AddTransaction(ctx, undated); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if _, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 50}); err != nil {
+				t.Fatal(err)
+			}
+
+			// the transaction without created_at is older than any day
+			today := time.Now().UTC().Format(DateLayout)
+			for _, from := range []string{"", today} {
+				statement, err := service.GetStatement(ctx, &pb.GetStatementRequest{AccountNumber: "1001", FromDate: from, ToDate: today})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if statement.OpeningBalance != 100 || len(statement.Lines) != 1 || statement.ClosingBalance != 150 {
+					t.Fatalf("statement from %q: %+v", from, statement)
+				}
+			}
+			expectBalances(t, service, map[string]int64{"1001": 150})
+			r, err := service.ExportStatement(ctx, &pb.ExportStatementRequest{
+				AccountNumber: "1001", FromDate: today, ToDate: today, Format: pb.StatementFormat_MT940,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if closing := ":62F:C" + mt940Date(today) + "EUR1,50"; !strings.Contains(string(r.Content), closing) {
+				t.Fatalf("statement without %s:\n%s", closing, r.Content)
+			}
+
+			// a statement with an undated line isn't rendered
+			undated := TransactionRecord{Id: "6ad44798000000000000000bcd397648", AccountNumber: "1001", Amount: 100, Type: DepositType}
+			statement := Statement{From: today, To: today, Lines: []StatementLine{{Transaction: undated}}}
+			if _, err := RenderMT940(statement, "EUR"); !errors.Is(err, InvalidInputError) {
+				t.Fatalf("expected InvalidInputError rendering MT940, got %v", err)
+			}
+			if _, err := RenderCamt053(statement, "EUR", time.Now()); !errors.Is(err, InvalidInputError) {
+				t.Fatalf("expected InvalidInputError rendering camt.053, got %v", err)
+			}
+		})
+	}
+}
+
+func TestMT940Text(t *testing.T) {
+	today := time.Now().UTC().Format(DateLayout)
+	line := StatementLine{Transaction: TransactionRecord{
+		Id: "6ad44798000000000000000bcd397648", AccountNumber: "1001", Amount: 100, Type: DepositType,
+		CreatedAt:   today + "T00:00:00.000000Z",
+		Description: strings.Repeat("invoice ", 8) + ":62F:C fake - " + strings.Repeat("x", 70) + " " + strings.Repeat("-", 65) + " " + strings.Repeat("y", 64),
+	}}
+	statement := Statement{Account: testAccount("1001"), From: today, To: today, Lines: []StatementLine{line}}
+	message, err := RenderMT940(statement, "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimRight(string(message), "\r\n"), "\r\n")
+	info := 0
+	for i, l := range lines {
+		if strings.HasPrefix(l, ":86:") {
+			info = i
+		}
+	}
+	// the description is wrapped on the spaces in at most 6 lines of 65 characters, the words too long are split
+	// and the rest is dropped
+	expected := []string{
+		":86:" + strings.TrimSpace(strings.Repeat("invoice ", 8)),
+		" :62F:C fake -",
+		strings.Repeat("x", 65),
+		strings.Repeat("x", 5),
+		" " + strings.Repeat("-", 64),
+		" -",
+	}
+	if got := lines[info : info+len(expected)+1]; strings.Join(got[:len(expected)], "\n") != strings.Join(expected, "\n") ||
+		!strings.HasPrefix(got[len(expected)], ":62F:") {
+		t.Fatalf("description lines:\n%s", strings.Join(got, "\n"))
+	}
+
+	// the accounts sharing the start of the number have different statements
+	other := statement
+	other.Account = testAccount("1001-2")
+	if statementId(statement) == statementId(other) || len(statementId(statement)) > 16 {
+		t.Fatalf("statement ids %q and %q", statementId(statement), statementId(other))
+	}
+}
+
+// TestStatementFiles compares the statement files with testdata/statement.camt053.xml and testdata/statement.mt940.txt,
+// the texts too long for the files are cut on characters
+func TestStatementFiles(t *testing.T) {
+	account := testAccount("1001")
+	account.IBAN = "DE89 3704 0044 0532 0130 00"
+	account.Name = strings.Repeat("Jürgen Müller ", 6)
+	withdrawal := TransactionRecord{
+		Id: "6ad44798000000000000000bcd397602", AccountNumber: "1001", Amount: 2500, Type: WithdrawalType, Currency: "EUR",
+		CreatedAt: "2024-01-10T09:30:00.000000Z", ValueDate: "2024-01-11",
+		Reference:   "Rechnung 2024/0001 Straßenbau-Süd-Ost",
+		Description: strings.Repeat("Zahlung für Straßenbau in Köln, ", 5),
+	}
+	reversal := withdrawal.Reversal()
+	reversal.Id = "6ad44798000000000000000bcd397603"
+	reversal.CreatedAt = "2024-01-12T16:00:00.000000Z"
+	statement := Statement{Account: account, From: "2024-01-01", To: "2024-01-31", OpeningBalance: -1000, ClosingBalance: -1000}
+	for _, transaction := range []TransactionRecord{
+		{
+			Id: "6ad44798000000000000000bcd397601", AccountNumber: "1001", Amount: 10000, Type: DepositType, Currency: "EUR",
+			CreatedAt: "2024-01-05T12:00:00.000000Z", Description: "Salary", TransferId: "transfer", CounterpartyAccountNumber: "1002",
+		},
+		withdrawal,
+		reversal,
+	} {
+		statement.add(transaction)
+	}
+
+	camt053, err := RenderCamt053(statement, "EUR", time.Date(2024, 2, 1, 8, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mt940, err := RenderMT940(statement, "EUR")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for file, content := range map[string][]byte{"statement.camt053.xml": camt053, "statement.mt940.txt": mt940} {
+		expected, err := os.ReadFile("testdata/" + file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != string(expected) {
+			t.Errorf("%s differs, got:\n%s", file, content)
+		}
+	}
+}
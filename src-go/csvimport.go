@@ -0,0 +1,395 @@
+package server
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The kinds of records ImportCSV loads
+const (
+	ImportAccounts     = "accounts"
+	ImportTransactions = "transactions"
+)
+
+// importFields are the fields of the records read from the CSV columns, the names are the same as in the storages
+var importFields = map[string][]string{
+	ImportAccounts:     {"number", "name", "address", "iban", "overdraft_limit", "currency"},
+	ImportTransactions: {"account_number", "amount", "type", "currency", "value_date", "created_at", "description", "reference"},
+}
+
+type ImportOptions struct {
+	// Kind is ImportAccounts or ImportTransactions
+	Kind string
+	// Columns maps the fields to the CSV columns, a field not mapped is read from the column of the same name
+	Columns map[string]string
+	// FromLine is the line of the file to start from, the header is line 1 and is always read
+	FromLine int
+	// DryRun checks all the records without writing them
+	DryRun bool
+	// DefaultCurrency is used for the accounts and transactions without a currency, as in AccountServiceConfig
+	DefaultCurrency string
+	// BatchSize is the most transactions written at once, the accounts are written one by one
+	// to tell the duplicates apart
+	BatchSize int
+	// Log receives a line for every record that is skipped, and for every failing one in the dry run
+	Log io.Writer
+}
+
+type ImportReport struct {
+	Imported   int
+	Duplicates int
+	Failed     int
+}
+
+// ImportError is returned when the import stops at a record, the records before Line are imported
+type ImportError struct {
+	Line int
+	Err  error
+}
+
+func (e *ImportError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *ImportError) Unwrap() error {
+	return e.Err
+}
+
+// ImportCSV loads the accounts or transactions of the CSV file into the storage. The records are validated
+// the same way as the storages do it, the transactions are also checked against the currency of their account.
+// The import writes to the storage directly: the balances aren't checked against the overdraft limits
+// and the watchers of the transactions aren't notified. The accounts that already exist are reported as duplicates
+// by the unique index on the account number and skipped, the transactions are written with the idempotency key
+// of their row, import-<SHA-256 of the fields>-<n>, where n counts the identical rows up to this one, and the ones
+// imported before are skipped the same way. Editing other rows doesn't change the keys, so an interrupted import
+// can be fixed and run again from the start or from a line.
+// A real import stops at the first failing record with ImportError, the dry run reports every failing record
+func ImportCSV(ctx context.Context, storage Storage, r io.Reader, opts ImportOptions) (ImportReport, error) {
+	fields, ok := importFields[opts.Kind]
+	if !ok {
+		return ImportReport{}, fmt.Errorf("%w: unknown kind of records %q", InvalidInputError, opts.Kind)
+	}
+	if opts.BatchSize < 1 {
+		opts.BatchSize = 1
+	}
+	if opts.Log == nil {
+		opts.Log = io.Discard
+	}
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return ImportReport{}, fmt.Errorf("%w: can't read the header: %v", InvalidInputError, err)
+	}
+	columns, err := importColumns(header, fields, opts.Columns)
+	if err != nil {
+		return ImportReport{}, err
+	}
+
+	im := &importer{
+		storage: storage, opts: opts, accounts: map[string]AccountRecord{}, seen: map[string]int{}, rows: map[string]int{},
+	}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		var line int
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			line = parseErr.StartLine
+			err = fmt.Errorf("%w: %v", InvalidInputError, parseErr.Err)
+		} else if err != nil {
+			return im.report, err
+		} else {
+			line, _ = reader.FieldPos(0)
+		}
+		var values map[string]string
+		if err == nil {
+			values = map[string]string{}
+			for field, column := range columns {
+				values[field] = strings.TrimSpace(row[column])
+			}
+		}
+		if line < opts.FromLine {
+			// the identical rows before the line are counted, so the rows get the same keys as from the start
+			if err == nil && opts.Kind == ImportTransactions {
+				im.transactionKey(values)
+			}
+			continue
+		}
+		if err == nil {
+			err = im.add(ctx, line, values)
+		}
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, InvalidInputError) {
+			im.report.Failed++
+			if opts.DryRun {
+				fmt.Fprintf(opts.Log, "line %d: %v\n", line, err)
+				continue
+			}
+		}
+		// the transactions read before the failing line are written, so the import resumes from it
+		if flushErr := im.flush(ctx); flushErr != nil {
+			return im.report, flushErr
+		}
+		var importErr *ImportError
+		if errors.As(err, &importErr) {
+			return im.report, err
+		}
+		return im.report, &ImportError{Line: line, Err: err}
+	}
+	if err := im.flush(ctx); err != nil {
+		return im.report, err
+	}
+	return im.report, nil
+}
+
+// importColumns returns the indexes of the columns of the fields, the fields without a column are left out
+func importColumns(header []string, fields []string, mapping map[string]string) (map[string]int, error) {
+	for field := range mapping {
+		known := false
+		for _, f := range fields {
+			known = known || f == field
+		}
+		if !known {
+			return nil, fmt.Errorf("%w: unknown field %q, the fields are %s", InvalidInputError, field, strings.Join(fields, ", "))
+		}
+	}
+	columns := map[string]int{}
+	for _, field := range fields {
+		name, mapped := mapping[field]
+		if !mapped {
+			name = field
+		}
+		index := -1
+		for i, h := range header {
+			if strings.TrimSpace(h) == name {
+				index = i
+			}
+		}
+		if index >= 0 {
+			columns[field] = index
+		} else if mapped {
+			return nil, fmt.Errorf("%w: no column %q for field %s", InvalidInputError, name, field)
+		}
+	}
+	return columns, nil
+}
+
+type importer struct {
+	storage Storage
+	opts    ImportOptions
+	report  ImportReport
+	// rows counts the rows of the transactions by their hash, the identical rows are told apart by the count
+	rows map[string]int
+	// accounts are the accounts of the imported transactions
+	accounts map[string]AccountRecord
+	// seen are the lines of the account numbers met in the file, the dry run finds the duplicates by them
+	seen map[string]int
+	// batch is the transactions to write, lines are their lines
+	batch []TransactionRecord
+	lines []int
+}
+
+func (im *importer) add(ctx context.Context, line int, values map[string]string) error {
+	if im.opts.Kind == ImportAccounts {
+		account, err := im.account(values)
+		if err != nil {
+			return err
+		}
+		return im.addAccount(ctx, line, account)
+	}
+	key := im.transactionKey(values)
+	transaction, err := im.transaction(ctx, values)
+	if err != nil {
+		return err
+	}
+	transaction.IdempotencyKey = key
+	if im.opts.DryRun {
+		_, err := im.storage.GetTransactionByIdempotencyKey(ctx, transaction.IdempotencyKey)
+		if errors.Is(err, NotFoundError) {
+			im.report.Imported++
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		im.report.Duplicates++
+		fmt.Fprintf(im.opts.Log, "line %d: transaction is imported already, skipped\n", line)
+		return nil
+	}
+	im.batch = append(im.batch, transaction)
+	im.lines = append(im.lines, line)
+	if len(im.batch) >= im.opts.BatchSize {
+		return im.flush(ctx)
+	}
+	return nil
+}
+
+// transactionKey returns the idempotency key of the next row with the values. The key is made of the fields
+// of the row, so it doesn't depend on the order of the columns or on the other rows of the file
+func (im *importer) transactionKey(values map[string]string) string {
+	data, _ := json.Marshal(values)
+	hash := sha256.Sum256(data)
+	row := hex.EncodeToString(hash[:])
+	im.rows[row]++
+	return fmt.Sprintf("import-%s-%d", row, im.rows[row])
+}
+
+func (im *importer) addAccount(ctx context.Context, line int, account AccountRecord) error {
+	if first, ok := im.seen[account.Number]; ok {
+		im.report.Duplicates++
+		fmt.Fprintf(im.opts.Log, "line %d: account %s is a duplicate of line %d, skipped\n", line, account.Number, first)
+		return nil
+	}
+	im.seen[account.Number] = line
+
+	var err error
+	if im.opts.DryRun {
+		if _, err = im.storage.GetAccount(ctx, account.Number); err == nil {
+			err = DuplicateKeyError
+		} else if errors.Is(err, NotFoundError) {
+			err = nil
+		}
+	} else {
+		_, err = im.storage.AddAccount(ctx, account)
+	}
+	if errors.Is(err, DuplicateKeyError) {
+		im.report.Duplicates++
+		fmt.Fprintf(im.opts.Log, "line %d: account %s already exists, skipped\n", line, account.Number)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	im.report.Imported++
+	return nil
+}
+
+// flush writes the batch of transactions in a single write, a failed batch is reported at its first line.
+// A batch with transactions imported before is written again one by one to skip them
+func (im *importer) flush(ctx context.Context) error {
+	if len(im.batch) == 0 {
+		return nil
+	}
+	batch, lines := im.batch, im.lines
+	im.batch, im.lines = nil, nil
+	_, err := im.storage.AddTransactions(ctx, batch)
+	if err == nil {
+		im.report.Imported += len(batch)
+		return nil
+	}
+	if !errors.Is(err, DuplicateKeyError) {
+		return &ImportError{Line: lines[0], Err: err}
+	}
+	for i, transaction := range batch {
+		_, err := im.storage.AddTransaction(ctx, transaction)
+		if errors.Is(err, DuplicateKeyError) {
+			im.report.Duplicates++
+			fmt.Fprintf(im.opts.Log, "line %d: transaction is imported already, skipped\n", lines[i])
+			continue
+		}
+		if err != nil {
+			return &ImportError{Line: lines[i], Err: err}
+		}
+		im.report.Imported++
+	}
+	return nil
+}
+
+func (im *importer) account(values map[string]string) (AccountRecord, error) {
+	account := AccountRecord{
+		Number:   values["number"],
+		Name:     values["name"],
+		Address:  values["address"],
+		IBAN:     values["iban"],
+		Currency: values["currency"],
+	}
+	if account.Currency == "" {
+		account.Currency = im.opts.DefaultCurrency
+	}
+	var err error
+	if account.OverdraftLimit, err = importInteger(values, "overdraft_limit"); err != nil {
+		return AccountRecord{}, err
+	}
+	return account, account.Validate()
+}
+
+func (im *importer) transaction(ctx context.Context, values map[string]string) (TransactionRecord, error) {
+	transaction := TransactionRecord{
+		AccountNumber: values["account_number"],
+		Type:          strings.ToUpper(values["type"]),
+		ValueDate:     values["value_date"],
+		Description:   values["description"],
+		Reference:     values["reference"],
+		CreatedAt:     FormatTimestamp(time.Now()),
+	}
+	if transaction.Type == "" {
+		transaction.Type = DepositType
+	}
+	var err error
+	if transaction.Amount, err = importInteger(values, "amount"); err != nil {
+		return TransactionRecord{}, err
+	}
+	if createdAt := values["created_at"]; createdAt != "" {
+		value, err := checkValue(timestampField, "created_at", createdAt)
+		if err != nil {
+			return TransactionRecord{}, fmt.Errorf("%w: created_at must be an RFC 3339 timestamp or a YYYY-MM-DD date", InvalidInputError)
+		}
+		transaction.CreatedAt = value.(string)
+	}
+
+	if transaction.AccountNumber == "" {
+		return TransactionRecord{}, fmt.Errorf("%w: account number is empty", InvalidInputError)
+	}
+	account, ok := im.accounts[transaction.AccountNumber]
+	if !ok {
+		account, err = im.storage.GetAccount(ctx, transaction.AccountNumber)
+		if errors.Is(err, NotFoundError) {
+			return TransactionRecord{}, fmt.Errorf("%w: account %s not found", InvalidInputError, transaction.AccountNumber)
+		}
+		if err != nil {
+			return TransactionRecord{}, fmt.Errorf("error getting account: %w", err)
+		}
+		im.accounts[account.Number] = account
+	}
+	currency := account.Currency
+	if currency == "" {
+		currency = im.opts.DefaultCurrency
+	}
+	transaction.Currency = values["currency"]
+	if transaction.Currency == "" {
+		transaction.Currency = currency
+	}
+	if transaction.Currency != currency {
+		return TransactionRecord{}, fmt.Errorf("%w: currency %s doesn't match currency %s of account %s",
+			InvalidInputError, transaction.Currency, currency, account.Number,
+		)
+	}
+	return transaction, transaction.Validate()
+}
+
+// importInteger parses the integer field, an empty field is 0
+func importInteger(values map[string]string, field string) (int64, error) {
+	if values[field] == "" {
+		return 0, nil
+	}
+	value, err := strconv.ParseInt(values[field], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s %q isn't an integer amount of minor units", InvalidInputError, field, values[field])
+	}
+	return value, nil
+}
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestImportCSVAgain(t *testing.T) {
+	ctx := context.Background()
+	file := "account_number,amount,type\n1001,100,deposit\n1001,40,withdrawal\n1001,40,withdrawal\n1002,5,deposit\n"
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			storage := openTestStorage(t, backend)
+			for _, number := range []string{"1001", "1002"} {
+				if _, err := storage.AddAccount(ctx, testAccount(number)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			opts := ImportOptions{Kind: ImportTransactions, DefaultCurrency: "EUR", BatchSize: 2}
+			importFile := func(file string, opts ImportOptions, expected ImportReport) {
+				t.Helper()
+				report, err := ImportCSV(ctx, storage, strings.NewReader(file), opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if report != expected {
+					t.Fatalf("import: got %+v, expected %+v", report, expected)
+				}
+			}
+
+			// the first run stops at line 5, the fixed file run from the start skips the lines imported before
+			bad := strings.Replace(file, "1002,5,", "1003,5,", 1)
+			_, err := ImportCSV(ctx, storage, strings.NewReader(bad), opts)
+			var importErr *ImportError
+			if !errors.As(err, &importErr) || importErr.Line != 5 {
+				t.Fatalf("expected the import to stop at line 5, got %v", err)
+			}
+			importFile(file, opts, ImportReport{Imported: 1, Duplicates: 3})
+			importFile(file, opts, ImportReport{Duplicates: 4})
+			dryRun := opts
+			dryRun.DryRun = true
+			importFile(file, dryRun, ImportReport{Duplicates: 4})
+
+			// the identical rows are told apart by their order, the rows before the line are counted as well
+			fromLine := opts
+			fromLine.FromLine = 4
+			importFile(file, fromLine, ImportReport{Duplicates: 2})
+			importFile(file+"1001,40,withdrawal\n", fromLine, ImportReport{Imported: 1, Duplicates: 2})
+			// the rows are the same whatever the order of the columns
+			importFile("type,account_number,amount\ndeposit,1001,100\n", opts, ImportReport{Duplicates: 1})
+
+			balances, err := storage.GetBalances(ctx, []string{"1001", "1002"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if balances["1001"] != 100-3*40 || balances["1002"] != 5 {
+				t.Fatalf("balances: got %v", balances)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+	"log"
+	"net/http"
+)
+
+// Cursor points at a page of a listing. The pages of a listing are read from the same snapshot of the storage
+// if the storage keeps searches open, so records written while paging through the listing neither shift
+// the pages nor show up in them.
+type Cursor struct {
+	// SearchId is the open search the page is read from, a new search is started if it's empty
+	SearchId   string `json:"s,omitempty"`
+	PageNumber int    `json:"p"`
+	PageSize   int    `json:"n"`
+	// Total is the amount of records counted when the search started
+	Total int `json:"t,omitempty"`
+	// Listing is the hash of the listing the cursor pages through, see listingHash
+	Listing string `json:"l,omitempty"`
+}
+
+// listingHash identifies the listing of the records of the kind matching the query, the transactions also by their
+// account, so the page tokens of one listing aren't taken for the pages of another one
+func listingHash(kind string, accountNumber string, query ListQuery) string {
+	data, _ := json.Marshal([]any{kind, accountNumber, query})
+	hash := sha256.Sum256(data)
+	return base64.RawURLEncoding.EncodeToString(hash[:12])
+}
+
+// CursorStorage is implemented by the storages that keep searches open between the pages
+type CursorStorage interface {
+	// ListAccountsFrom returns the page of the accounts matching the query the cursor points at, the total amount
+	// of them and the cursor of the next page, which is nil after the last page. The query is only used when
+	// a search is started. InvalidInputError is returned if the search of the cursor expired
+	ListAccountsFrom(ctx context.Context, query ListQuery, cursor Cursor) ([]AccountRecord, int, *Cursor, error)
+
+	// ListTransactionsFrom is ListAccountsFrom for the transactions of the account
+	ListTransactionsFrom(ctx context.Context, accountNumber string, query ListQuery, cursor Cursor) ([]TransactionRecord, int, *Cursor, error)
+}
+
+var _ CursorStorage = (*VaultStorage)(nil)
+
+func (v *VaultStorage) ListAccountsFrom(ctx context.Context, query ListQuery, cursor Cursor) ([]AccountRecord, int, *Cursor, error) {
+	query, err := query.check(accountFields)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return listDocumentsFrom[AccountRecord](
+		ctx, v.client, v.config.LedgerName, v.config.AccountsCollectionName, cursor,
+		query.vaultQuery(),
+	)
+}
+
+func (v *VaultStorage) ListTransactionsFrom(ctx context.Context, accountNumber string, query ListQuery, cursor Cursor) ([]TransactionRecord, int, *Cursor, error) {
+	query, err := query.check(transactionFields)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	return listDocumentsFrom[TransactionRecord](
+		ctx, v.client, v.config.LedgerName, v.config.TransactionsCollectionName, cursor,
+		query.vaultQuery(FieldComparison{Field: "account_number", Operator: EQ, Value: accountNumber}),
+	)
+}
+
+// listDocumentsFrom reads the page of a Vault search kept open with KeepOpen. Vault reads the pages of an open
+// search from the snapshot of the ledger taken when the search started and closes it after the last page.
+// The documents are counted right after the search starts, so the total covers at least the snapshot.
+func listDocumentsFrom[T AccountRecord | TransactionRecord](
+	ctx context.Context,
+	client *ClientWithResponses,
+	ledgerName string,
+	collectionName string,
+	cursor Cursor,
+	query *Query,
+) ([]T, int, *Cursor, error) {
+	if err := checkPage(cursor.PageSize, cursor.PageNumber); err != nil {
+		return nil, 0, nil, err
+	}
+	req := DocumentSearchRequest{Page: cursor.PageNumber, PerPage: cursor.PageSize, KeepOpen: ptr(true)}
+	if cursor.SearchId != "" {
+		req.SearchId = &cursor.SearchId
+	} else {
+		req.Query = query
+	}
+	r, err := client.SearchDocumentWithResponse(ctx, ledgerName, collectionName, req)
+	if err != nil {
+		return nil, 0, nil, fmt.Errorf("error searching documents: %w", err)
+	}
+	if cursor.SearchId != "" && (r.StatusCode() == http.StatusBadRequest || r.StatusCode() == http.StatusNotFound) {
+		return nil, 0, nil, fmt.Errorf("%w: search expired, start over: %s", InvalidInputError, r.Body)
+	}
+	if r.StatusCode() == http.StatusBadRequest {
+		return nil, 0, nil, fmt.Errorf("%w: %s", InvalidInputError, r.Body)
+	}
+	if r.StatusCode() != 200 {
+		return nil, 0, nil, fmt.Errorf("bad response searching for documents: %s %s", r.Status(), r.Body)
+	}
+
+	docs := make([]T, 0, len(r.JSON200.Revisions))
+	for _, d := range r.JSON200.Revisions {
+		doc, err := documentToRecord[T](d.Document)
+		if err != nil {
+			return nil, 0, nil, err
+		}
+		docs = append(docs, doc)
+	}
+
+	total := cursor.Total
+	if cursor.SearchId == "" {
+		if total, err = countDocuments(ctx, client, ledgerName, collectionName, query); err != nil {
+			return nil, 0, nil, err
+		}
+	}
+	var next *Cursor
+	// Vault keeps the search open until a page comes out short, a full last page has no next one either
+	if r.JSON200.SearchId != "" && len(docs) == cursor.PageSize && cursor.PageNumber*cursor.PageSize < total {
+		next = &Cursor{
+			SearchId:   r.JSON200.SearchId,
+			PageNumber: cursor.PageNumber + 1,
+			PageSize:   cursor.PageSize,
+			Total:      total,
+			Listing:    cursor.Listing,
+		}
+	} else if r.JSON200.SearchId != "" && len(docs) == cursor.PageSize {
+		closeSearch(ctx, client, ledgerName, collectionName, r.JSON200.SearchId, cursor)
+	}
+	return docs, total, next, nil
+}
+
+// closeSearch reads the page after the last one of the open search, Vault closes a search once a page comes out short.
+// The search expires anyway, so a failure is only logged
+func closeSearch(ctx context.Context, client *ClientWithResponses, ledgerName string, collectionName string, searchId string, cursor Cursor) {
+	r, err := client.SearchDocumentWithResponse(ctx, ledgerName, collectionName, DocumentSearchRequest{
+		Page: cursor.PageNumber + 1, PerPage: cursor.PageSize, KeepOpen: ptr(true), SearchId: &searchId,
+	})
+	if err == nil && r.StatusCode() != 200 {
+		err = fmt.Errorf("%s %s", r.Status(), r.Body)
+	}
+	if err != nil {
+		log.Printf("failed to close search %s: %v", searchId, err)
+	}
+}
+
+// numberedNext returns the cursor of the next page for the storages that read the pages by their number,
+// nil is returned after the last page
+func (c Cursor) numberedNext(total int) *Cursor {
+	if c.PageNumber*c.PageSize >= total {
+		return nil
+	}
+	return &Cursor{PageNumber: c.PageNumber + 1, PageSize: c.PageSize, Listing: c.Listing}
+}
+
+// pageTokenMacSize is the length the HMAC signing the page tokens is truncated to
+const pageTokenMacSize = 16
+
+// encodePageToken makes an opaque page token of the cursor signed with the key, so the clients can't change
+// the search, the page or the listing of the cursor. An empty token means there are no more pages
+func encodePageToken(key []byte, cursor *Cursor) string {
+	if cursor == nil {
+		return ""
+	}
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(append(data, pageTokenMac(key, data)...))
+}
+
+// decodePageToken returns the cursor the token points at, InvalidInputError is returned for malformed tokens
+// and for the tokens that aren't signed with the key
+func decodePageToken(key []byte, token string) (Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(data) < pageTokenMacSize {
+		return Cursor{}, fmt.Errorf("%w: malformed page token", InvalidInputError)
+	}
+	data, mac := data[:len(data)-pageTokenMacSize], data[len(data)-pageTokenMacSize:]
+	if !hmac.Equal(mac, pageTokenMac(key, data)) {
+		return Cursor{}, fmt.Errorf("%w: page token wasn't issued by this service", InvalidInputError)
+	}
+	var cursor Cursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return Cursor{}, fmt.Errorf("%w: malformed page token", InvalidInputError)
+	}
+	return cursor, nil
+}
+
+func pageTokenMac(key []byte, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)[:pageTokenMacSize]
+}
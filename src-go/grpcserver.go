@@ -6,33 +6,81 @@ import (
 	pb "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/proto"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log"
+	"net/http"
+	"strings"
 )
 
 type GrpcServersConfig struct {
-	WebGrpcDisableCORS bool `default:"true"`
+	WebGrpcDisableCORS bool   `default:"true"`
+	Backend            string `default:"vault"`
 	VaultConfig
+	AccountServiceConfig
+	Sqlite SqliteConfig
 }
 
-// GetGrpcServers initializes the account service according to the `conf`
-// and returns a normal grpc server and a grpc-web version which are ready to serve
-func GetGrpcServers(conf GrpcServersConfig) (*grpc.Server, *grpcweb.WrappedGrpcServer, error) {
+// NewStorage creates the storage backend selected by `conf.Backend`
+func NewStorage(conf GrpcServersConfig) (Storage, error) {
+	switch conf.Backend {
+	case "vault":
+		storage, err := NewVaultStorage(conf.VaultConfig)
+		if err != nil {
+			return nil, err
+		}
+		return storage, nil
+	case "memory":
+		return NewMemoryStorage(), nil
+	case "sqlite":
+		storage, err := NewSqliteStorage(conf.Sqlite)
+		if err != nil {
+			return nil, err
+		}
+		return storage, nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend %q", conf.Backend)
+	}
+}
 
-	storage, err := NewVaultStorage(conf.VaultConfig)
+// GetGrpcServers initializes the account service according to the `conf` and returns a normal grpc server,
+// a grpc-web version and the handler of the statement downloads, which are ready to serve
+func GetGrpcServers(conf GrpcServersConfig) (*grpc.Server, *grpcweb.WrappedGrpcServer, http.Handler, error) {
+
+	storage, err := NewStorage(conf)
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to start vault storage: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to start storage: %w", err)
 	}
 
-	// create collections in the Vault if not exist
+	// create collections in the storage if not exist
 	err = storage.InitCollections(context.Background())
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to init collections: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to init collections: %w", err)
 	}
 
 	// start the service
-	accountServiceServer := &AccountService{vaultStorage: storage}
+	accountServiceServer, err := NewAccountService(storage, conf.AccountServiceConfig)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to start account service: %w", err)
+	}
+
+	// verify the ledger before serving and keep verifying it in the background
+	var opts []grpc.ServerOption
+	var checkedMonitor LedgerMonitor
+	if monitor, ok := storage.(LedgerMonitor); ok && conf.StateCheckInterval > 0 {
+		checkedMonitor = monitor
+		if err := checkLedgerAtStartup(context.Background(), monitor, startupCheckAttempts, startupCheckDelay); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to check the ledger: %w", err)
+		}
+		go monitorLedger(context.Background(), monitor, conf.StateCheckInterval)
+		opts = append(opts,
+			grpc.UnaryInterceptor(ledgerIntegrityInterceptor(monitor)),
+			grpc.StreamInterceptor(ledgerIntegrityStreamInterceptor(monitor)),
+		)
+	}
 
 	// create a normal grpc server
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(opts...)
 	pb.RegisterAccountServiceServer(grpcServer, accountServiceServer)
 
 	// create a grpc-web version of the server
@@ -43,5 +91,78 @@ func GetGrpcServers(conf GrpcServersConfig) (*grpc.Server, *grpcweb.WrappedGrpcS
 		},
 		))
 
-	return grpcServer, grpcWebServer, nil
+	return grpcServer, grpcWebServer, statementDownloadHandler(accountServiceServer, checkedMonitor), nil
+}
+
+// ledgerIntegrityInterceptor refuses all calls once the ledger failed the integrity check
+func ledgerIntegrityInterceptor(monitor LedgerMonitor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := monitor.LedgerErr(); err != nil {
+			return nil, status.Errorf(codes.DataLoss, "refusing to serve: %v", err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// ledgerIntegrityStreamInterceptor is ledgerIntegrityInterceptor for the streaming calls
+func ledgerIntegrityStreamInterceptor(monitor LedgerMonitor) grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := monitor.LedgerErr(); err != nil {
+			return status.Errorf(codes.DataLoss, "refusing to serve: %v", err)
+		}
+		return handler(srv, stream)
+	}
+}
+
+// statementDownloadHandler serves the files of ExportStatement over plain HTTP for the clients that can't speak gRPC:
+//
+//	GET /statements?account_number=1&from_date=2024-01-01&to_date=2024-01-31&format=mt940
+//
+// The format is the name of a pb.StatementFormat in any case, camt_053 by default. The ledger is checked
+// by the `monitor` as for the gRPC calls, it is nil if the ledger isn't checked
+func statementDownloadHandler(service *AccountService, monitor LedgerMonitor) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		if monitor != nil {
+			if err := monitor.LedgerErr(); err != nil {
+				http.Error(w, fmt.Sprintf("refusing to serve: %v", err), http.StatusServiceUnavailable)
+				return
+			}
+		}
+		query := r.URL.Query()
+		req := &pb.ExportStatementRequest{
+			AccountNumber: query.Get("account_number"),
+			FromDate:      query.Get("from_date"),
+			ToDate:        query.Get("to_date"),
+		}
+		if format := query.Get("format"); format != "" {
+			value, ok := pb.StatementFormat_value[strings.ToUpper(format)]
+			if !ok {
+				http.Error(w, fmt.Sprintf("unknown statement format %q", format), http.StatusBadRequest)
+				return
+			}
+			req.Format = pb.StatementFormat(value)
+		}
+
+		res, err := service.ExportStatement(r.Context(), req)
+		if err != nil {
+			st, _ := status.FromError(err)
+			switch st.Code() {
+			case codes.InvalidArgument, codes.FailedPrecondition:
+				http.Error(w, st.Message(), http.StatusBadRequest)
+			case codes.NotFound:
+				http.Error(w, st.Message(), http.StatusNotFound)
+			default:
+				log.Printf("failed to export statement: %v", err)
+				http.Error(w, "failed to export statement", http.StatusInternalServerError)
+			}
+			return
+		}
+		w.Header().Set("Content-Type", res.ContentType)
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.FileName))
+		_, _ = w.Write(res.Content)
+	})
 }
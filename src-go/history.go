@@ -0,0 +1,104 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+)
+
+// HistoryStorage is implemented by the storages that keep every revision of the documents
+type HistoryStorage interface {
+	// GetAccountHistory returns the revisions of the account from the oldest to the newest one and the diffs
+	// between them, NotFoundError is returned if there is no such account
+	GetAccountHistory(ctx context.Context, number string) (AccountHistory, error)
+}
+
+var _ HistoryStorage = (*VaultStorage)(nil)
+
+// AccountRevision is the account as it was written by a ledger transaction
+type AccountRevision struct {
+	Revision      string
+	TransactionId string
+	Account       AccountRecord
+	// Creator and Ts are taken from the metadata Vault adds to the documents, they tell which Vault identity wrote
+	// the revision and when. The person or system behind the change is Account.Actor
+	Creator string
+	Ts      int64
+}
+
+// AccountDiff is the difference between revisions of the account as computed by Vault
+type AccountDiff struct {
+	// DiffIds identifies the compared revisions
+	DiffIds string
+	Diff    map[string]interface{}
+}
+
+type AccountHistory struct {
+	Revisions []AccountRevision
+	Diffs     []AccountDiff
+}
+
+// GetAccountHistory pages through the audit and the diffs of the account document in Vault
+func (v *VaultStorage) GetAccountHistory(ctx context.Context, number string) (AccountHistory, error) {
+	account, err := v.GetAccount(ctx, number)
+	if err != nil {
+		return AccountHistory{}, err
+	}
+
+	var history AccountHistory
+	for page := 1; ; page++ {
+		r, err := v.client.AuditDocumentWithResponse(ctx, v.config.LedgerName, v.config.AccountsCollectionName, account.Id,
+			DocumentAuditRequest{Page: page, PerPage: vaultMaxPerPage},
+		)
+		if err != nil {
+			return AccountHistory{}, fmt.Errorf("error auditing document: %w", err)
+		}
+		if r.StatusCode() != 200 {
+			return AccountHistory{}, fmt.Errorf("bad response auditing document: %s %s", r.Status(), r.Body)
+		}
+		for _, rev := range r.JSON200.Revisions {
+			record, err := documentToRecord[AccountRecord](rev.Document)
+			if err != nil {
+				return AccountHistory{}, err
+			}
+			creator, ts := vaultMetadata(rev.Document)
+			history.Revisions = append(history.Revisions, AccountRevision{
+				Revision:      rev.Revision,
+				TransactionId: rev.TransactionId,
+				Account:       record,
+				Creator:       creator,
+				Ts:            ts,
+			})
+		}
+		if len(r.JSON200.Revisions) < vaultMaxPerPage {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		r, err := v.client.DiffDocumentWithResponse(ctx, v.config.LedgerName, v.config.AccountsCollectionName, account.Id,
+			DocumentDiffRequest{Page: page, PerPage: vaultMaxPerPage},
+		)
+		if err != nil {
+			return AccountHistory{}, fmt.Errorf("error diffing document: %w", err)
+		}
+		if r.StatusCode() != 200 {
+			return AccountHistory{}, fmt.Errorf("bad response diffing document: %s %s", r.Status(), r.Body)
+		}
+		for _, diff := range r.JSON200.Diffs {
+			history.Diffs = append(history.Diffs, AccountDiff{DiffIds: diff.DiffIds, Diff: diff.Diff})
+		}
+		if len(r.JSON200.Diffs) < vaultMaxPerPage {
+			break
+		}
+	}
+	return history, nil
+}
+
+// vaultMetadata returns the creator and the timestamp of the document revision from the _vault_md field
+func vaultMetadata(document Document) (string, int64) {
+	md, _ := document["_vault_md"].(map[string]interface{})
+	creator, _ := md["creator"].(string)
+	ts, _ := md["ts"].(float64)
+	return creator, int64(ts)
+}
@@ -0,0 +1,342 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+	"github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultproof"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// LedgerMonitor is implemented by the storages that check the integrity of the ledger in the background
+type LedgerMonitor interface {
+	// CheckLedger verifies that the current state of the ledger extends the trusted state and trusts it from now on.
+	// An error wrapping RewrittenHistoryError is returned if the history of the ledger was rewritten,
+	// the error sticks and every later check fails with it. An error wrapping LedgerUnavailableError
+	// is returned if the ledger can't be read or returns something the check can't use, the check
+	// can be retried then.
+	CheckLedger(ctx context.Context) error
+
+	// LedgerErr returns the error the ledger failed the integrity check with, it is nil while the ledger is intact
+	LedgerErr() error
+}
+
+var _ LedgerMonitor = (*VaultStorage)(nil)
+
+var RewrittenHistoryError = fmt.Errorf("ledger history was rewritten")
+var LedgerUnavailableError = fmt.Errorf("ledger is unavailable")
+
+// startupCheckAttempts is how many times the ledger is checked before serving while it is unavailable,
+// the delay between the attempts doubles from startupCheckDelay
+const (
+	startupCheckAttempts = 5
+	startupCheckDelay    = time.Second
+)
+
+// witness is a document revision written by the storage. Vault has no endpoint for the consistency proof
+// between two states of the ledger, it only proves documents, so the states are linked through the proofs
+// of a revision written in between them.
+type witness struct {
+	collection string
+	documentId string
+	txId       uint64
+}
+
+// recordWitness remembers the revision written by the Vault transaction as the witness
+// if it is newer than the current one
+func (v *VaultStorage) recordWitness(collection string, documentId string, txId *string) {
+	if txId == nil {
+		return
+	}
+	id, err := strconv.ParseUint(*txId, 10, 64)
+	if err != nil {
+		log.Printf("bad transaction id %q of document %s: %v", *txId, documentId, err)
+		return
+	}
+	v.witnessMu.Lock()
+	defer v.witnessMu.Unlock()
+	if id > v.witness.txId {
+		v.witness = witness{collection: collection, documentId: documentId, txId: id}
+	}
+}
+
+func (v *VaultStorage) lastWitness() witness {
+	v.witnessMu.Lock()
+	defer v.witnessMu.Unlock()
+	return v.witness
+}
+
+func (v *VaultStorage) LedgerErr() error {
+	if err := v.ledgerErr.Load(); err != nil {
+		return *err
+	}
+	return nil
+}
+
+// CheckLedger gets the current state of the ledger from Vault and proves it from the trusted state. The state
+// is trusted on first use, after that it may only move forward: the proof of the witness since the trusted state
+// links the trusted state with the witness and the proof of the witness since the current state links the witness
+// with the current state. If nothing was written to the collections of the storage since the trusted state
+// the current state can't be proven yet, the check is skipped and the trusted state is kept until the next write.
+// The check gives up after StateCheckTimeout.
+func (v *VaultStorage) CheckLedger(ctx context.Context) error {
+	if err := v.LedgerErr(); err != nil {
+		return err
+	}
+	if v.config.StateCheckTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, v.config.StateCheckTimeout)
+		defer cancel()
+	}
+
+	r, err := v.client.GetCurrentStateWithResponse(ctx, v.config.LedgerName)
+	if err != nil {
+		return fmt.Errorf("%w: error getting ledger state: %w", LedgerUnavailableError, err)
+	}
+	if r.StatusCode() != 200 {
+		return fmt.Errorf("%w: bad response getting ledger state: %s %s", LedgerUnavailableError, r.Status(), r.Body)
+	}
+	current, err := ledgerState(r.JSON200)
+	if err != nil {
+		return fmt.Errorf("%w: %w", LedgerUnavailableError, err)
+	}
+	trusted := v.trusted()
+	if trusted.Db != "" && current.Db != trusted.Db {
+		return fmt.Errorf("trusted state is of ledger %q, Vault returned the state of %q", trusted.Db, current.Db)
+	}
+
+	switch {
+	case trusted.TxId == 0:
+		log.Printf("trusting the state of the ledger %s on first use at transaction %d", current.Db, current.TxId)
+		return v.swapTrustedState(trusted, current)
+	case current.TxId < trusted.TxId:
+		return v.failLedger(fmt.Errorf("%w: ledger went back from transaction %d to %d",
+			RewrittenHistoryError, trusted.TxId, current.TxId))
+	case current.TxId == trusted.TxId:
+		if !bytes.Equal(current.TxHash, trusted.TxHash) {
+			return v.failLedger(fmt.Errorf("%w: hash of transaction %d changed", RewrittenHistoryError, current.TxId))
+		}
+		return nil
+	}
+
+	w, err := v.findWitness(ctx, trusted.TxId)
+	if err != nil {
+		return err
+	}
+	if w.txId < trusted.TxId {
+		log.Printf("can't prove the ledger state %d from %d yet, nothing was written since", current.TxId, trusted.TxId)
+		return nil
+	}
+	linked, err := v.proveWitness(ctx, w, trusted.TxId, trusted)
+	if err != nil {
+		return err
+	}
+	switch {
+	case w.txId < current.TxId:
+		proven, err := v.proveWitness(ctx, w, current.TxId, linked)
+		if err != nil {
+			return err
+		}
+		if !proven.equal(current) {
+			return v.failLedger(fmt.Errorf("%w: proven state %d doesn't match the current state %d",
+				RewrittenHistoryError, proven.TxId, current.TxId))
+		}
+	case w.txId > current.TxId:
+		// the witness was written after the current state was read, the current state is proven
+		// to be a prefix of the state of the witness and the newer state is trusted
+		proven, err := v.proveWitness(ctx, w, current.TxId, current)
+		if err != nil {
+			return err
+		}
+		if !proven.equal(linked) {
+			return v.failLedger(fmt.Errorf("%w: state %d proven from the current state %d doesn't match the state proven from the trusted one",
+				RewrittenHistoryError, proven.TxId, current.TxId))
+		}
+		current = linked
+	default:
+		if !linked.equal(current) {
+			return v.failLedger(fmt.Errorf("%w: proven state %d doesn't match the current state %d",
+				RewrittenHistoryError, linked.TxId, current.TxId))
+		}
+	}
+	return v.swapTrustedState(trusted, current)
+}
+
+// findWitness returns the witness written at or after the transaction. The witness is remembered when the storage
+// writes, after a restart or on an instance that doesn't write it is looked up in Vault: the newest document of every
+// collection at its latest revision. The witness is older than the transaction if nothing was written since
+func (v *VaultStorage) findWitness(ctx context.Context, since uint64) (witness, error) {
+	w := v.lastWitness()
+	if w.txId >= since {
+		return w, nil
+	}
+	for _, collection := range []string{v.config.AccountsCollectionName, v.config.TransactionsCollectionName} {
+		r, err := v.client.SearchDocumentWithResponse(ctx, v.config.LedgerName, collection,
+			DocumentSearchRequest{Page: 1, PerPage: 1, Query: newestFirst},
+		)
+		if err != nil {
+			return witness{}, fmt.Errorf("%w: error searching for a witness: %w", LedgerUnavailableError, err)
+		}
+		if r.StatusCode() != 200 {
+			return witness{}, fmt.Errorf("%w: bad response searching for a witness: %s %s", LedgerUnavailableError, r.Status(), r.Body)
+		}
+		for _, revision := range r.JSON200.Revisions {
+			if documentId, ok := revision.Document["_id"].(string); ok {
+				v.recordWitness(collection, documentId, &revision.TransactionId)
+			}
+		}
+	}
+	return v.lastWitness(), nil
+}
+
+// proveWitness verifies the proof of the witness since the transaction against the trusted state
+// and returns the state proven by it. Only a proof that decodes but doesn't verify fails the ledger, a missing
+// or malformed proof may be a glitch of Vault and is retried
+func (v *VaultStorage) proveWitness(ctx context.Context, w witness, since uint64, trusted LedgerState) (LedgerState, error) {
+	r, err := v.client.GetDocumentProofWithResponse(ctx, v.config.LedgerName, w.collection, w.documentId,
+		DocumentProofRequest{TransactionId: int(w.txId), ProofSinceTransactionId: ptr(int(since))},
+	)
+	if err != nil {
+		return LedgerState{}, fmt.Errorf("%w: error getting document proof: %w", LedgerUnavailableError, err)
+	}
+	if r.StatusCode() != 200 {
+		return LedgerState{}, fmt.Errorf("%w: bad response getting the proof of document %s written at transaction %d: %s %s",
+			LedgerUnavailableError, w.documentId, w.txId, r.Status(), r.Body)
+	}
+	state, err := vaultproof.VerifyDocumentProof(r.JSON200, w.documentId, &SchemaImmutableState{
+		TxId:   ptr(strconv.FormatUint(trusted.TxId, 10)),
+		TxHash: ptr(trusted.TxHash),
+	})
+	if errors.Is(err, vaultproof.ErrMalformedProof) {
+		return LedgerState{}, fmt.Errorf("%w: %w", LedgerUnavailableError, err)
+	}
+	if err != nil {
+		return LedgerState{}, v.failLedger(fmt.Errorf("%w: %w", RewrittenHistoryError, err))
+	}
+	proven, err := ledgerState(state)
+	if err != nil {
+		return LedgerState{}, fmt.Errorf("%w: %w", LedgerUnavailableError, err)
+	}
+	return proven, nil
+}
+
+// failLedger makes the error stick, the storage refuses to serve from now on. It is only called
+// for a proven inconsistency of the ledger, the state file has to be removed to trust the ledger again
+func (v *VaultStorage) failLedger(err error) error {
+	v.ledgerErr.CompareAndSwap(nil, &err)
+	return err
+}
+
+// trusted returns the trusted state of the ledger
+func (v *VaultStorage) trusted() LedgerState {
+	v.stateMu.Lock()
+	defer v.stateMu.Unlock()
+	return v.trustedState
+}
+
+// swapTrustedState trusts the state proven from the `old` trusted state. The proofs are verified without holding
+// the lock, if the trusted state moved on meanwhile the state is dropped, the next proof starts from the new one
+func (v *VaultStorage) swapTrustedState(old LedgerState, state LedgerState) error {
+	v.stateMu.Lock()
+	defer v.stateMu.Unlock()
+	if !v.trustedState.equal(old) {
+		return nil
+	}
+	return v.trustState(state)
+}
+
+// trustState makes the state trusted and saves it to the state file, it must be called with stateMu held
+func (v *VaultStorage) trustState(state LedgerState) error {
+	if v.config.StatePath != "" {
+		if err := saveLedgerState(v.config.StatePath, state); err != nil {
+			return err
+		}
+	}
+	v.trustedState = state
+	return nil
+}
+
+// loadLedgerState reads the trusted state from the file, the state is empty if there is no file yet
+func loadLedgerState(path string) (LedgerState, error) {
+	var state LedgerState
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return state, fmt.Errorf("error reading ledger state: %w", err)
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return state, fmt.Errorf("error reading ledger state %s: %w", path, err)
+	}
+	return state, nil
+}
+
+// saveLedgerState writes the state to a temporary file and renames it, so the file always holds a whole state
+func saveLedgerState(path string, state LedgerState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("error marshalling ledger state: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("error saving ledger state: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("error saving ledger state: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error saving ledger state: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error saving ledger state: %w", err)
+	}
+	return nil
+}
+
+// checkLedgerAtStartup checks the ledger before serving, the check is retried while the ledger is unavailable
+func checkLedgerAtStartup(ctx context.Context, monitor LedgerMonitor, attempts int, delay time.Duration) error {
+	for attempt := 1; ; attempt++ {
+		err := monitor.CheckLedger(ctx)
+		if !errors.Is(err, LedgerUnavailableError) || attempt == attempts {
+			return err
+		}
+		log.Printf("failed to check the ledger state, retrying in %v: %v", delay, err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// monitorLedger checks the ledger every interval until the context is done
+func monitorLedger(ctx context.Context, monitor LedgerMonitor, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := monitor.CheckLedger(ctx)
+			if errors.Is(err, RewrittenHistoryError) {
+				log.Printf("ALERT: refusing to serve, %v", err)
+				return
+			}
+			if err != nil {
+				log.Printf("failed to check the ledger state: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultfake"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// switchableVault serves the requests with the current fake, the fake is switched to tamper with the ledger
+type switchableVault struct {
+	handler atomic.Value
+}
+
+func (s *switchableVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	(*s.handler.Load().(*http.Handler)).ServeHTTP(w, r)
+}
+
+// use switches to the handler, it is stored by pointer as atomic.Value only takes values of the same type
+func (s *switchableVault) use(handler http.Handler) {
+	s.handler.Store(&handler)
+}
+
+func newLedgerTestStorage(t *testing.T, config VaultConfig) *VaultStorage {
+	t.Helper()
+	storage, err := NewVaultStorage(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return storage
+}
+
+// writeLedger writes the account and the deposits to it through the storage
+func writeLedger(t *testing.T, storage *VaultStorage, deposits int) {
+	t.Helper()
+	ctx := context.Background()
+	if err := storage.InitCollections(ctx); err != nil {
+		t.Fatal(err)
+	}
+	_, err := storage.AddAccount(ctx, AccountRecord{Number: "1", Name: "Alice", Currency: "EUR"})
+	if err != nil && !errors.Is(err, DuplicateKeyError) {
+		t.Fatal(err)
+	}
+	for i := 0; i < deposits; i++ {
+		_, err := storage.AddTransaction(ctx, TransactionRecord{
+			AccountNumber: "1", Amount: int64(i + 1), Type: DepositType, Currency: "EUR",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestCheckLedgerAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	vault := &switchableVault{}
+	vault.use(vaultfake.NewServer())
+	server := httptest.NewServer(vault)
+	defer server.Close()
+	config := VaultConfig{
+		Host: server.URL, ApiKey: "key", LedgerName: "default",
+		AccountsCollectionName: "accounts", TransactionsCollectionName: "transactions",
+		StatePath: filepath.Join(t.TempDir(), "ledger-state.json"),
+	}
+	// the other instances share the ledger, but not the state file
+	otherConfig := config
+	otherConfig.StatePath = ""
+
+	storage := newLedgerTestStorage(t, config)
+	writeLedger(t, storage, 3)
+	if err := storage.CheckLedger(ctx); err != nil {
+		t.Fatal(err)
+	}
+	trusted := storage.trusted()
+
+	tests := []struct {
+		name string
+		// change writes to the ledger while the storage is restarted
+		change   func(t *testing.T)
+		tampered bool
+	}{
+		{
+			name: "appended by another instance",
+			change: func(t *testing.T) {
+				writeLedger(t, newLedgerTestStorage(t, otherConfig), 2)
+			},
+		},
+		{
+			name: "rewritten with a longer history",
+			change: func(t *testing.T) {
+				vault.use(vaultfake.NewServer())
+				writeLedger(t, newLedgerTestStorage(t, otherConfig), 10)
+			},
+			tampered: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.change(t)
+			// the restarted storage only reads the ledger, it has no witness of its own
+			restarted := newLedgerTestStorage(t, config)
+			if !restarted.trusted().equal(trusted) {
+				t.Fatalf("trusted state %d wasn't loaded from the state file", trusted.TxId)
+			}
+			err := restarted.CheckLedger(ctx)
+			if !tt.tampered {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if restarted.trusted().TxId <= trusted.TxId {
+					t.Fatalf("trusted state stayed at %d, the appended transactions weren't proven", trusted.TxId)
+				}
+				trusted = restarted.trusted()
+				return
+			}
+			if !errors.Is(err, RewrittenHistoryError) {
+				t.Fatalf("expected RewrittenHistoryError, got %v", err)
+			}
+			if !errors.Is(restarted.LedgerErr(), RewrittenHistoryError) {
+				t.Fatalf("ledger error didn't stick: %v", restarted.LedgerErr())
+			}
+		})
+	}
+}
+
+// garbleProofs serves the requests with the fake and drops the dual proofs from the document proofs it returns
+func garbleProofs(fake http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/proof") {
+			fake.ServeHTTP(w, r)
+			return
+		}
+		rec := httptest.NewRecorder()
+		fake.ServeHTTP(rec, r)
+		var proof map[string]any
+		if err := json.Unmarshal(rec.Body.Bytes(), &proof); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if vtx, ok := proof["verifiableTx"].(map[string]any); ok {
+			delete(vtx, "dualProof")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(rec.Code)
+		json.NewEncoder(w).Encode(proof)
+	})
+}
+
+func TestCheckLedgerUnavailable(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		disrupt func(fake *vaultfake.Server, vault *switchableVault)
+	}{
+		{
+			name: "state unavailable",
+			disrupt: func(fake *vaultfake.Server, vault *switchableVault) {
+				fake.FailNext("GetCurrentState", http.StatusServiceUnavailable)
+			},
+		},
+		{
+			name: "proof unavailable",
+			disrupt: func(fake *vaultfake.Server, vault *switchableVault) {
+				fake.FailNext("GetDocumentProof", http.StatusServiceUnavailable)
+			},
+		},
+		{
+			name: "proof not found",
+			disrupt: func(fake *vaultfake.Server, vault *switchableVault) {
+				fake.FailNext("GetDocumentProof", http.StatusNotFound)
+			},
+		},
+		{
+			name: "malformed proof",
+			disrupt: func(fake *vaultfake.Server, vault *switchableVault) {
+				vault.use(garbleProofs(fake))
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := vaultfake.NewServer()
+			vault := &switchableVault{}
+			vault.use(fake)
+			server := httptest.NewServer(vault)
+			defer server.Close()
+			storage := newLedgerTestStorage(t, VaultConfig{
+				Host: server.URL, ApiKey: "key", LedgerName: "default",
+				AccountsCollectionName: "accounts", TransactionsCollectionName: "transactions",
+				StatePath: filepath.Join(t.TempDir(), "ledger-state.json"),
+			})
+			writeLedger(t, storage, 1)
+			if err := storage.CheckLedger(ctx); err != nil {
+				t.Fatal(err)
+			}
+			trusted := storage.trusted()
+			// the new state has to be proven through the witness
+			writeLedger(t, storage, 1)
+
+			tt.disrupt(fake, vault)
+			err := storage.CheckLedger(ctx)
+			if !errors.Is(err, LedgerUnavailableError) || errors.Is(err, RewrittenHistoryError) {
+				t.Fatalf("expected LedgerUnavailableError, got %v", err)
+			}
+			if err := storage.LedgerErr(); err != nil {
+				t.Fatalf("ledger error stuck: %v", err)
+			}
+
+			vault.use(fake)
+			if err := storage.CheckLedger(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if storage.trusted().TxId <= trusted.TxId {
+				t.Fatalf("trusted state stayed at %d after the retry", trusted.TxId)
+			}
+		})
+	}
+}
+
+func TestCheckLedgerAtStartup(t *testing.T) {
+	ctx := context.Background()
+	storage, fake := newFakeVaultStorage(t)
+	writeLedger(t, storage, 1)
+
+	fake.FailNext("GetCurrentState", http.StatusServiceUnavailable)
+	fake.FailNext("GetCurrentState", http.StatusServiceUnavailable)
+	err := checkLedgerAtStartup(ctx, storage, 2, time.Millisecond)
+	if !errors.Is(err, LedgerUnavailableError) {
+		t.Fatalf("expected LedgerUnavailableError after the attempts ran out, got %v", err)
+	}
+
+	fake.FailNext("GetCurrentState", http.StatusServiceUnavailable)
+	fake.FailNext("GetCurrentState", http.StatusServiceUnavailable)
+	if err := checkLedgerAtStartup(ctx, storage, 3, time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if storage.trusted().TxId == 0 {
+		t.Fatal("ledger state wasn't trusted after the retries")
+	}
+}
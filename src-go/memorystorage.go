@@ -0,0 +1,346 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"sync"
+)
+
+var _ Storage = (*MemoryStorage)(nil)
+
+// MemoryStorage is a storage that keeps the models in memory, it mimics VaultStorage and is meant
+// for local development and tests
+type MemoryStorage struct {
+	mu           sync.RWMutex
+	lastId       int
+	accounts     []AccountRecord
+	transactions []TransactionRecord
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{}
+}
+
+func (m *MemoryStorage) ListAccounts(_ context.Context, query ListQuery, pageSize int, pageNumber int) ([]AccountRecord, int, error) {
+	query, err := query.check(accountFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	accounts, err := filterRecords(m.accounts, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	page, err := paginate(accounts, pageSize, pageNumber)
+	return page, len(accounts), err
+}
+
+func (m *MemoryStorage) GetAccount(_ context.Context, number string) (AccountRecord, error) {
+	return m.findAccount(func(a AccountRecord) bool { return a.Number == number })
+}
+
+func (m *MemoryStorage) GetAccountById(_ context.Context, id string) (AccountRecord, error) {
+	return m.findAccount(func(a AccountRecord) bool { return a.Id == id })
+}
+
+func (m *MemoryStorage) GetAccountByIBAN(_ context.Context, iban string) (AccountRecord, error) {
+	return m.findAccount(func(a AccountRecord) bool { return a.IBAN == iban })
+}
+
+func (m *MemoryStorage) GetAccountByIdempotencyKey(_ context.Context, key string) (AccountRecord, error) {
+	return m.findAccount(func(a AccountRecord) bool { return key != "" && a.IdempotencyKey == key })
+}
+
+// findAccount returns the first account matching the predicate
+func (m *MemoryStorage) findAccount(match func(AccountRecord) bool) (AccountRecord, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, a := range m.accounts {
+		if match(a) {
+			return a, nil
+		}
+	}
+	return AccountRecord{}, NotFoundError
+}
+
+func (m *MemoryStorage) ListTransactions(_ context.Context, accountNumber string, query ListQuery, pageSize int, pageNumber int) ([]TransactionRecord, int, error) {
+	query, err := query.check(transactionFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var transactions []TransactionRecord
+	for _, t := range m.transactions {
+		if t.AccountNumber == accountNumber {
+			transactions = append(transactions, t)
+		}
+	}
+	transactions, err = filterRecords(transactions, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	page, err := paginate(transactions, pageSize, pageNumber)
+	return page, len(transactions), err
+}
+
+// TransactionsCursor returns the id of the newest transaction, the ids are generated in the order of the writes
+func (m *MemoryStorage) TransactionsCursor(_ context.Context) (string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if len(m.transactions) == 0 {
+		return "", nil
+	}
+	return m.transactions[len(m.transactions)-1].Id, nil
+}
+
+func (m *MemoryStorage) ListTransactionsAfter(_ context.Context, cursor string, pageSize int, pageNumber int) ([]TransactionRecord, string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	start := sort.Search(len(m.transactions), func(i int) bool { return m.transactions[i].Id > cursor })
+	page, err := paginate(m.transactions[start:], pageSize, pageNumber)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(page) > 0 {
+		cursor = page[len(page)-1].Id
+	}
+	return page, cursor, nil
+}
+
+func (m *MemoryStorage) GetTransaction(_ context.Context, id string) (TransactionRecord, error) {
+	return m.findTransaction(func(t TransactionRecord) bool { return t.Id == id })
+}
+
+func (m *MemoryStorage) GetTransactionByIdempotencyKey(_ context.Context, key string) (TransactionRecord, error) {
+	return m.findTransaction(func(t TransactionRecord) bool { return key != "" && t.IdempotencyKey == key })
+}
+
+// findTransaction returns the first transaction matching the predicate
+func (m *MemoryStorage) findTransaction(match func(TransactionRecord) bool) (TransactionRecord, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for _, t := range m.transactions {
+		if match(t) {
+			return t, nil
+		}
+	}
+	return TransactionRecord{}, NotFoundError
+}
+
+func (m *MemoryStorage) GetTransferTransactions(_ context.Context, transferId string) ([]TransactionRecord, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var transactions []TransactionRecord
+	for _, t := range m.transactions {
+		if t.TransferId == transferId {
+			transactions = append(transactions, t)
+		}
+	}
+	return transactions, nil
+}
+
+func (m *MemoryStorage) GetReversals(_ context.Context, transactionIds []string) ([]TransactionRecord, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var reversals []TransactionRecord
+	for _, t := range m.transactions {
+		if t.ReversedTransactionId != "" && slices.Contains(transactionIds, t.ReversedTransactionId) {
+			reversals = append(reversals, t)
+		}
+	}
+	return reversals, nil
+}
+
+func (m *MemoryStorage) GetBalance(_ context.Context, accountNumber string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var balance int64
+	for _, t := range m.transactions {
+		if t.AccountNumber == accountNumber {
+			balance += t.SignedAmount()
+		}
+	}
+	return balance, nil
+}
+
+// GetBalanceBefore compares the times as strings, the empty ones of the transactions without a time go first
+func (m *MemoryStorage) GetBalanceBefore(_ context.Context, accountNumber string, before string) (int64, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	var balance int64
+	for _, t := range m.transactions {
+		if t.AccountNumber == accountNumber && t.CreatedAt < before {
+			balance += t.SignedAmount()
+		}
+	}
+	return balance, nil
+}
+
+func (m *MemoryStorage) GetBalances(ctx context.Context, accountNumbers []string) (map[string]int64, error) {
+	states, err := m.GetBalanceStates(ctx, accountNumbers)
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[string]int64, len(states))
+	for number, state := range states {
+		balances[number] = state.Balance
+	}
+	return balances, nil
+}
+
+func (m *MemoryStorage) GetBalanceStates(_ context.Context, accountNumbers []string) (map[string]BalanceState, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	states := make(map[string]BalanceState, len(accountNumbers))
+	for _, number := range accountNumbers {
+		states[number] = BalanceState{}
+	}
+	for _, t := range m.transactions {
+		if state, ok := states[t.AccountNumber]; ok {
+			state.Balance += t.SignedAmount()
+			state.Sequence = max(state.Sequence, t.Sequence)
+			states[t.AccountNumber] = state
+		}
+	}
+	return states, nil
+}
+
+func (m *MemoryStorage) AddAccount(_ context.Context, account AccountRecord) (string, error) {
+	if err := account.Validate(); err != nil {
+		return "", err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// unique indexes on the account number and the idempotency key
+	for _, a := range m.accounts {
+		if a.Number == account.Number || account.IdempotencyKey != "" && a.IdempotencyKey == account.IdempotencyKey {
+			return "", DuplicateKeyError
+		}
+	}
+	account.Id = m.nextId()
+	m.accounts = append(m.accounts, account)
+	return account.Id, nil
+}
+
+func (m *MemoryStorage) UpdateAccount(_ context.Context, account AccountRecord) error {
+	if err := account.Validate(); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, a := range m.accounts {
+		if a.Number == account.Number {
+			account.Id = a.Id
+			m.accounts[i] = account
+			return nil
+		}
+	}
+	return NotFoundError
+}
+
+func (m *MemoryStorage) AddTransaction(_ context.Context, transaction TransactionRecord) (string, error) {
+	if err := transaction.Validate(); err != nil {
+		return "", err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkUniqueKeys([]TransactionRecord{transaction}); err != nil {
+		return "", err
+	}
+	transaction.Id = m.nextId()
+	m.transactions = append(m.transactions, transaction)
+	return transaction.Id, nil
+}
+
+func (m *MemoryStorage) AddTransactions(_ context.Context, transactions []TransactionRecord) ([]string, error) {
+	for _, t := range transactions {
+		if err := t.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.checkUniqueKeys(transactions); err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, t := range transactions {
+		t.Id = m.nextId()
+		m.transactions = append(m.transactions, t)
+		ids = append(ids, t.Id)
+	}
+	return ids, nil
+}
+
+// checkUniqueKeys mimics the unique indexes on the idempotency keys of the transactions and on the sequences
+// of the withdrawals of the accounts, must be called with the lock held
+func (m *MemoryStorage) checkUniqueKeys(transactions []TransactionRecord) error {
+	type sequenceKey struct {
+		accountNumber string
+		sequence      int64
+	}
+	keys := map[string]bool{}
+	sequences := map[sequenceKey]bool{}
+	for _, t := range m.transactions {
+		keys[t.IdempotencyKey] = true
+		sequences[sequenceKey{t.AccountNumber, t.Sequence}] = true
+	}
+	for _, t := range transactions {
+		if t.IdempotencyKey != "" {
+			if keys[t.IdempotencyKey] {
+				return DuplicateKeyError
+			}
+			keys[t.IdempotencyKey] = true
+		}
+		if t.Sequence != 0 {
+			if sequences[sequenceKey{t.AccountNumber, t.Sequence}] {
+				return DuplicateKeyError
+			}
+			sequences[sequenceKey{t.AccountNumber, t.Sequence}] = true
+		}
+	}
+	return nil
+}
+
+// InitCollections does nothing, the collections always exist in memory
+func (m *MemoryStorage) InitCollections(_ context.Context) error {
+	return nil
+}
+
+// nextId generates a document id, must be called with the lock held
+func (m *MemoryStorage) nextId() string {
+	m.lastId++
+	return fmt.Sprintf("%032x", m.lastId)
+}
+
+// paginate returns a copy of the page of `records`, pages are numbered from 1 as in Vault
+func paginate[T any](records []T, pageSize int, pageNumber int) ([]T, error) {
+	if err := checkPage(pageSize, pageNumber); err != nil {
+		return nil, err
+	}
+	start := (pageNumber - 1) * pageSize
+	if start >= len(records) {
+		return nil, nil
+	}
+	end := min(start+pageSize, len(records))
+	return append([]T(nil), records[start:end]...), nil
+}
@@ -1,13 +1,26 @@
 package server
 
-import "fmt"
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"time"
+)
 
 type AccountRecord struct {
-	Id      string `json:"id"`
-	Number  string `json:"number"`
-	Name    string `json:"name"`
-	Address string `json:"address"`
-	IBAN    string `json:"iban"`
+	Id             string `json:"id"`
+	Number         string `json:"number"`
+	Name           string `json:"name"`
+	Address        string `json:"address"`
+	IBAN           string `json:"iban"`
+	OverdraftLimit int64  `json:"overdraft_limit"`
+	// Currency is an ISO 4217 code, it can't be changed after the account is created
+	Currency string `json:"currency"`
+	// IdempotencyKey is the key of the request that created the account, replays of the request return this account
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	// Actor is who made the last change of the account as told by the client, it is empty until the account changes
+	Actor string `json:"actor,omitempty"`
 }
 
 func (a AccountRecord) Validate() error {
@@ -17,14 +30,84 @@ func (a AccountRecord) Validate() error {
 	if a.Name == "" {
 		return fmt.Errorf("%w: name is empty", InvalidInputError)
 	}
+	if a.OverdraftLimit < 0 {
+		return fmt.Errorf("%w: overdraft limit is negative", InvalidInputError)
+	}
+	if _, ok := CurrencyExponent(a.Currency); !ok {
+		return fmt.Errorf("%w: unknown currency %q", InvalidInputError, a.Currency)
+	}
+	if len(a.IdempotencyKey) > maxIdempotencyKeyLength {
+		return fmt.Errorf("%w: idempotency key is longer than %d bytes", InvalidInputError, maxIdempotencyKeyLength)
+	}
+	if len(a.Actor) > maxActorLength {
+		return fmt.Errorf("%w: actor is longer than %d bytes", InvalidInputError, maxActorLength)
+	}
 	return nil
 }
 
+// SameRequest tells if the accounts were created by the same request. Only the fields set at creation that can't
+// change afterwards are compared, the name, the address, the IBAN and the actor are changed by UpdateAccount
+func (a AccountRecord) SameRequest(other AccountRecord) bool {
+	return a.IdempotencyKey == other.IdempotencyKey &&
+		a.Number == other.Number &&
+		a.Currency == other.Currency &&
+		a.OverdraftLimit == other.OverdraftLimit
+}
+
+// Transaction types, the same as the names of pb.TransactionType values
+const (
+	DepositType    = "DEPOSIT"
+	WithdrawalType = "WITHDRAWAL"
+)
+
 type TransactionRecord struct {
 	Id            string `json:"id"`
 	AccountNumber string `json:"account_number"`
-	Amount        int64  `json:"amount"`
-	Type          string `json:"type"`
+	// Amount is in minor units of the currency, e.g. cents
+	Amount   int64  `json:"amount"`
+	Type     string `json:"type"`
+	Currency string `json:"currency"`
+	// TransferId links both legs of a transfer, empty for standalone transactions
+	TransferId                string `json:"transfer_id"`
+	CounterpartyAccountNumber string `json:"counterparty_account_number"`
+	// ReversedTransactionId is the id of the transaction this one compensates, empty for regular transactions
+	ReversedTransactionId string `json:"reversed_transaction_id"`
+	// CreatedAt is the time the service recorded the transaction at in TimestampLayout,
+	// empty for transactions recorded before the timestamps
+	CreatedAt string `json:"created_at"`
+	// ValueDate is the date the transaction takes effect on in DateLayout, it is optional
+	ValueDate   string `json:"value_date"`
+	Description string `json:"description"`
+	// Reference is an external reference, e.g. an invoice number, the transactions can be searched by it
+	Reference string            `json:"reference"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	// IdempotencyKey is the key of the request that created the transaction,
+	// replays of the request return this transaction
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	// Sequence numbers the withdrawals checked against the balance of the account from 1, the storages keep it unique
+	// per account, so a withdrawal checked against a balance that changed meanwhile fails to be written. It isn't set
+	// for the other transactions, the Vault storage writes them with random negative ones, see vaultSequence
+	Sequence int64 `json:"sequence,omitempty"`
+}
+
+// maxReferenceLength is the longest reference that fits into the Vault index, so is maxIdempotencyKeyLength.
+// maxActorLength keeps the actors as short
+const (
+	maxReferenceLength      = 256
+	maxIdempotencyKeyLength = 256
+	maxActorLength          = 256
+)
+
+// TimestampLayout is the UTC layout of the timestamps in the storages. It has a fixed width, so the timestamps
+// are ordered chronologically when compared as strings
+const TimestampLayout = "2006-01-02T15:04:05.000000Z07:00"
+
+// DateLayout is the layout of the dates in the storages, it is ordered chronologically as well
+const DateLayout = time.DateOnly
+
+// FormatTimestamp formats the time in TimestampLayout
+func FormatTimestamp(t time.Time) string {
+	return t.UTC().Format(TimestampLayout)
 }
 
 func (t TransactionRecord) Validate() error {
@@ -34,12 +117,97 @@ func (t TransactionRecord) Validate() error {
 	if t.Amount == 0 {
 		return fmt.Errorf("%w: amount is empty", InvalidInputError)
 	}
-	if t.Type == "" {
-		return fmt.Errorf("%w: type is empty", InvalidInputError)
+	if t.Amount < 0 {
+		return fmt.Errorf("%w: amount is negative", InvalidInputError)
+	}
+	if t.Type != DepositType && t.Type != WithdrawalType {
+		return fmt.Errorf("%w: unknown type %q", InvalidInputError, t.Type)
+	}
+	if _, ok := CurrencyExponent(t.Currency); !ok {
+		return fmt.Errorf("%w: unknown currency %q", InvalidInputError, t.Currency)
+	}
+	if t.TransferId != "" && t.CounterpartyAccountNumber == "" {
+		return fmt.Errorf("%w: counterparty account number of the transfer is empty", InvalidInputError)
+	}
+	if t.CounterpartyAccountNumber == t.AccountNumber {
+		return fmt.Errorf("%w: counterparty account is the same account", InvalidInputError)
+	}
+	if t.ValueDate != "" {
+		if _, err := time.Parse(DateLayout, t.ValueDate); err != nil {
+			return fmt.Errorf("%w: value date %q isn't a YYYY-MM-DD date", InvalidInputError, t.ValueDate)
+		}
+	}
+	if len(t.Reference) > maxReferenceLength {
+		return fmt.Errorf("%w: reference is longer than %d bytes", InvalidInputError, maxReferenceLength)
+	}
+	for key := range t.Metadata {
+		if key == "" {
+			return fmt.Errorf("%w: metadata key is empty", InvalidInputError)
+		}
+	}
+	if len(t.IdempotencyKey) > maxIdempotencyKeyLength {
+		return fmt.Errorf("%w: idempotency key is longer than %d bytes", InvalidInputError, maxIdempotencyKeyLength)
 	}
 	return nil
 }
 
+// SameRequest tells if the transactions were created by the same request, the id, the time the service
+// recorded the transactions at and the sequence they were written with aren't compared
+func (t TransactionRecord) SameRequest(other TransactionRecord) bool {
+	t.Id, other.Id = "", ""
+	t.CreatedAt, other.CreatedAt = "", ""
+	t.Sequence, other.Sequence = 0, 0
+	// no metadata is stored as none
+	if len(t.Metadata) == 0 {
+		t.Metadata = nil
+	}
+	if len(other.Metadata) == 0 {
+		other.Metadata = nil
+	}
+	return reflect.DeepEqual(t, other)
+}
+
+// SignedAmount returns the amount as it affects the balance: negative for withdrawals
+func (t TransactionRecord) SignedAmount() int64 {
+	if t.Type == WithdrawalType {
+		return -t.Amount
+	}
+	return t.Amount
+}
+
+// Reversal returns the compensating transaction that cancels out this one on the same account. The reversal has
+// the idempotency key of the transaction it reverses, so the unique index of the storage keeps it the only one
+func (t TransactionRecord) Reversal() TransactionRecord {
+	reversal := TransactionRecord{
+		AccountNumber:             t.AccountNumber,
+		Amount:                    t.Amount,
+		Type:                      DepositType,
+		Currency:                  t.Currency,
+		CounterpartyAccountNumber: t.CounterpartyAccountNumber,
+		ReversedTransactionId:     t.Id,
+		// the reversal is found by the reference of the original transaction
+		Reference:      t.Reference,
+		IdempotencyKey: "reversal-" + t.Id,
+	}
+	if t.Type == DepositType {
+		reversal.Type = WithdrawalType
+	}
+	return reversal
+}
+
 type Validateble interface {
 	Validate() error
 }
+
+// newDocumentId generates a random id of the same format as Vault document ids
+func newDocumentId() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// isDocumentId checks that the id has the format of Vault document ids, all storages use this format
+func isDocumentId(id string) bool {
+	b, err := hex.DecodeString(id)
+	return err == nil && len(b) == 16
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+	"github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultproof"
+	"net/http"
+	"strconv"
+)
+
+// ProofStorage is implemented by the storages backed by a tamper-evident ledger
+type ProofStorage interface {
+	// GetTransactionProof returns the proof that the transaction is in the ledger, the proof is verified
+	// before it is returned. NotFoundError is returned if there is no such transaction, an error wrapping
+	// vaultproof.ErrInvalidProof if the proof doesn't verify and an error wrapping LedgerUnavailableError
+	// if the proof can't be read.
+	GetTransactionProof(ctx context.Context, id string) (TransactionProof, error)
+}
+
+var _ ProofStorage = (*VaultStorage)(nil)
+
+// LedgerState is the state of the ledger after a transaction, the hash covers the whole history up to the transaction
+type LedgerState struct {
+	Db     string `json:"db"`
+	TxId   uint64 `json:"tx_id"`
+	TxHash []byte `json:"tx_hash"`
+}
+
+func (s LedgerState) equal(other LedgerState) bool {
+	return s.Db == other.Db && s.TxId == other.TxId && bytes.Equal(s.TxHash, other.TxHash)
+}
+
+// TransactionProof is the Vault proof of the document of a transaction
+type TransactionProof struct {
+	// Transaction is the transaction as it is proven by the proof
+	Transaction TransactionRecord
+	// DocumentProof is the DocumentProofResponse as returned by Vault, in JSON
+	DocumentProof []byte
+	// TrustedState is the state the proof was verified against, it is empty if no state was trusted before
+	TrustedState LedgerState
+	// State is the state of the ledger proven by the proof, it is trusted from now on
+	State LedgerState
+}
+
+// GetTransactionProof requests the proof of the transaction since the trusted state and verifies it.
+// The first proof is requested since the first transaction of the ledger, after that the newest verified
+// state is trusted. A proven state is only trusted if it was proven from the state trusted at the moment,
+// so the trusted state only moves forward along a verified history.
+func (v *VaultStorage) GetTransactionProof(ctx context.Context, id string) (TransactionProof, error) {
+	trusted := v.trusted()
+	req := DocumentProofRequest{}
+	if trusted.TxId > 0 {
+		req.ProofSinceTransactionId = ptr(int(trusted.TxId))
+	}
+	r, err := v.client.GetDocumentProofWithResponse(ctx, v.config.LedgerName, v.config.TransactionsCollectionName, id, req)
+	if err != nil {
+		return TransactionProof{}, fmt.Errorf("%w: error getting document proof: %w", LedgerUnavailableError, err)
+	}
+	if r.StatusCode() == http.StatusNotFound {
+		return TransactionProof{}, NotFoundError
+	}
+	if r.StatusCode() != 200 {
+		return TransactionProof{}, fmt.Errorf("%w: bad response getting document proof: %s %s", LedgerUnavailableError, r.Status(), r.Body)
+	}
+
+	transaction, state, err := VerifyTransactionProof(r.Body, id, trusted)
+	if err != nil {
+		return TransactionProof{}, err
+	}
+	proof := TransactionProof{
+		Transaction:   transaction,
+		DocumentProof: r.Body,
+		TrustedState:  trusted,
+		State:         state,
+	}
+	if state.TxId > trusted.TxId {
+		if err := v.swapTrustedState(trusted, state); err != nil {
+			return TransactionProof{}, err
+		}
+	}
+	return proof, nil
+}
+
+// VerifyTransactionProof verifies the Vault document proof of the transaction in JSON against the trusted state
+// without contacting Vault, an empty trusted state means that the proof must start from the first transaction
+// of the ledger. The transaction decoded from the proven document and the state proven by the proof are returned.
+// The returned error wraps vaultproof.ErrInvalidProof if the proof doesn't verify
+// and vaultproof.ErrMalformedProof as well if it can't be decoded.
+func VerifyTransactionProof(documentProof []byte, id string, trusted LedgerState) (TransactionRecord, LedgerState, error) {
+	var proof DocumentProofResponse
+	if err := json.Unmarshal(documentProof, &proof); err != nil {
+		return TransactionRecord{}, LedgerState{}, fmt.Errorf("%w: bad document proof: %s", vaultproof.ErrMalformedProof, err)
+	}
+	var trustedState *SchemaImmutableState
+	if trusted.TxId > 0 {
+		trustedState = &SchemaImmutableState{
+			TxId:   ptr(strconv.FormatUint(trusted.TxId, 10)),
+			TxHash: ptr(trusted.TxHash),
+		}
+	}
+	state, err := vaultproof.VerifyDocumentProof(&proof, id, trustedState)
+	if err != nil {
+		return TransactionRecord{}, LedgerState{}, fmt.Errorf("proof of transaction %s: %w", id, err)
+	}
+	proven, err := ledgerState(state)
+	if err != nil {
+		return TransactionRecord{}, LedgerState{}, err
+	}
+
+	doc, err := vaultproof.DecodeDocument(&proof)
+	if err != nil {
+		return TransactionRecord{}, LedgerState{}, fmt.Errorf("proof of transaction %s: %w", id, err)
+	}
+	transaction, err := documentToRecord[TransactionRecord](doc)
+	if err != nil {
+		return TransactionRecord{}, LedgerState{}, fmt.Errorf("proof of transaction %s: %w: %s", id, vaultproof.ErrInvalidProof, err)
+	}
+	return transaction, proven, nil
+}
+
+// SameDocument tells if the transactions are stored as the same Vault document. Vault keeps the numbers
+// of the documents as float64, so the records are compared by their canonical JSON with the numbers
+// as Vault holds them: the amounts above 2^53 compare equal if Vault can't tell them apart
+func SameDocument(a, b TransactionRecord) (bool, error) {
+	ca, err := canonicalDocument(a)
+	if err != nil {
+		return false, err
+	}
+	cb, err := canonicalDocument(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(ca, cb), nil
+}
+
+// canonicalDocument returns the JSON of the record with the keys sorted and the numbers converted to float64
+func canonicalDocument(record TransactionRecord) ([]byte, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling document: %w", err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("error unmarshalling document: %w", err)
+	}
+	return json.Marshal(doc)
+}
+
+func ledgerState(state *SchemaImmutableState) (LedgerState, error) {
+	var s LedgerState
+	if state.Db != nil {
+		s.Db = *state.Db
+	}
+	if state.TxId != nil {
+		txId, err := strconv.ParseUint(*state.TxId, 10, 64)
+		if err != nil {
+			return LedgerState{}, fmt.Errorf("bad ledger state transaction id %q: %w", *state.TxId, err)
+		}
+		s.TxId = txId
+	}
+	if state.TxHash != nil {
+		s.TxHash = *state.TxHash
+	}
+	return s, nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"testing"
+)
+
+func TestSameDocument(t *testing.T) {
+	transaction := TransactionRecord{
+		Id: "6ad44798000000000000000bcd397648", AccountNumber: "1001", Amount: 1<<53 + 1,
+		Type: DepositType, Currency: "EUR", Description: "deposit", CreatedAt: "2024-05-01T10:00:00Z",
+	}
+	// the proven document is decoded from the protobuf Struct Vault stores it as, its numbers are float64
+	proven, err := documentToRecord[TransactionRecord](map[string]any{
+		"_id": transaction.Id, "account_number": "1001", "amount": float64(transaction.Amount),
+		"type": DepositType, "currency": "EUR", "description": "deposit", "created_at": "2024-05-01T10:00:00Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(t *TransactionRecord)
+		same   bool
+	}{
+		{name: "as stored", change: func(t *TransactionRecord) {}, same: true},
+		{name: "no metadata", change: func(t *TransactionRecord) { t.Metadata = map[string]string{} }, same: true},
+		{name: "other amount", change: func(t *TransactionRecord) { t.Amount = 1 << 54 }},
+		{name: "other description", change: func(t *TransactionRecord) { t.Description = "withdrawal" }},
+		{name: "metadata", change: func(t *TransactionRecord) { t.Metadata = map[string]string{"order": "1"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := transaction
+			tt.change(&expected)
+			same, err := SameDocument(expected, proven)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if same != tt.same {
+				t.Fatalf("SameDocument(%+v, %+v) = %v", expected, proven, same)
+			}
+		})
+	}
+}
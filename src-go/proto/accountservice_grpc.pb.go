@@ -19,20 +19,96 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AccountService_ListAccounts_FullMethodName      = "/account_service.AccountService/ListAccounts"
-	AccountService_ListTransactions_FullMethodName  = "/account_service.AccountService/ListTransactions"
-	AccountService_CreateAccount_FullMethodName     = "/account_service.AccountService/CreateAccount"
-	AccountService_CreateTransaction_FullMethodName = "/account_service.AccountService/CreateTransaction"
+	AccountService_ListAccounts_FullMethodName        = "/account_service.AccountService/ListAccounts"
+	AccountService_GetAccount_FullMethodName          = "/account_service.AccountService/GetAccount"
+	AccountService_ListTransactions_FullMethodName    = "/account_service.AccountService/ListTransactions"
+	AccountService_ExportTransactions_FullMethodName  = "/account_service.AccountService/ExportTransactions"
+	AccountService_WatchTransactions_FullMethodName   = "/account_service.AccountService/WatchTransactions"
+	AccountService_CreateAccount_FullMethodName       = "/account_service.AccountService/CreateAccount"
+	AccountService_UpdateAccount_FullMethodName       = "/account_service.AccountService/UpdateAccount"
+	AccountService_GetAccountHistory_FullMethodName   = "/account_service.AccountService/GetAccountHistory"
+	AccountService_CreateTransaction_FullMethodName   = "/account_service.AccountService/CreateTransaction"
+	AccountService_CreateTransactions_FullMethodName  = "/account_service.AccountService/CreateTransactions"
+	AccountService_GetAccountBalance_FullMethodName   = "/account_service.AccountService/GetAccountBalance"
+	AccountService_GetStatement_FullMethodName        = "/account_service.AccountService/GetStatement"
+	AccountService_ExportStatement_FullMethodName     = "/account_service.AccountService/ExportStatement"
+	AccountService_Transfer_FullMethodName            = "/account_service.AccountService/Transfer"
+	AccountService_ReverseTransaction_FullMethodName  = "/account_service.AccountService/ReverseTransaction"
+	AccountService_GetTransactionProof_FullMethodName = "/account_service.AccountService/GetTransactionProof"
 )
 
 // AccountServiceClient is the client API for AccountService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AccountServiceClient interface {
+	// ListAccounts returns a list of accounts. The accounts can be filtered and sorted by number, name, address,
+	// iban, currency and overdraft_limit
 	ListAccounts(ctx context.Context, in *ListAccountsRequest, opts ...grpc.CallOption) (*ListAccountsResponse, error)
+	// GetAccount returns the account with its balance, the account is looked up by the id, the number or the IBAN.
+	// Fails with NOT_FOUND if there is no such account
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	// ListTransactions returns a list of transactions for a given account. The transactions can be filtered
+	// and sorted by amount, type, counterparty_account_number, transfer_id, currency, reversed_transaction_id,
+	// created_at, value_date and reference. created_at is compared with RFC 3339 timestamps or YYYY-MM-DD dates (midnight UTC),
+	// e.g. created_at GE "2024-01-01" and created_at LT "2024-02-01" select January
 	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	// ExportTransactions streams all the transactions of the account, it reads them page by page from a single
+	// Vault search, so the export is consistent even while transactions are written. The transactions can be
+	// filtered and sorted as in ListTransactions. Fails with NOT_FOUND if the account doesn't exist
+	ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (AccountService_ExportTransactionsClient, error)
+	// WatchTransactions streams the transactions created from now on, of the account or of all the accounts
+	// if account_number is empty. The transactions created by this instance arrive right away, the ones created
+	// by other instances arrive once Vault is polled for them. Fails with RESOURCE_EXHAUSTED if the client
+	// falls too far behind, the transactions created meanwhile can be read with ListTransactions
+	WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (AccountService_WatchTransactionsClient, error)
+	// CreateAccount creates a new account. Retries are made safe by sending an idempotency key in the idempotency-key
+	// metadata: a replay with the same key returns the id of the account created by the first request,
+	// a request with the same key and a different account fails with FAILED_PRECONDITION
 	CreateAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	// UpdateAccount changes the name, address and IBAN of the account, the other fields can't be changed.
+	// The actor metadata tells who makes the change, it is recorded with the account and is required.
+	// Fails with NOT_FOUND if the account doesn't exist
+	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
+	// GetAccountHistory returns every revision of the account with the Vault transaction that wrote it
+	// and the field-level diffs between the revisions. Fails with UNIMPLEMENTED if the storage isn't Vault
+	GetAccountHistory(ctx context.Context, in *GetAccountHistoryRequest, opts ...grpc.CallOption) (*GetAccountHistoryResponse, error)
+	// CreateTransaction creates a new transaction for a given account. It takes an idempotency key the same way
+	// as CreateAccount, a replay returns the id of the recorded transaction without checking the balance again.
+	// Fails with NOT_FOUND if the account doesn't exist and with FAILED_PRECONDITION if the currency
+	// doesn't match the account or a withdrawal would take the balance below the overdraft limit of the account
 	CreateTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
+	// CreateTransactions creates up to VAULT_MAXBATCHSIZE transactions in a single write to Vault. Every transaction
+	// is checked as in CreateTransaction, the withdrawals against the balance including the transactions before them
+	// in the request. The results follow the order of the transactions: the id of the created transaction
+	// or the status code and the message of the failure. With all_or_nothing no transaction is created if any fails,
+	// the others are reported as ABORTED. Idempotency keys aren't supported, a request with the idempotency-key metadata
+	// fails with INVALID_ARGUMENT. A failed all or nothing request can be retried
+	CreateTransactions(ctx context.Context, in *CreateTransactionsRequest, opts ...grpc.CallOption) (*CreateTransactionsResponse, error)
+	// GetAccountBalance returns the current balance of a given account
+	GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error)
+	// GetStatement returns the transactions of the account recorded within the period with the running balance,
+	// the opening and the closing balance and the totals of the period. Fails with NOT_FOUND if the account
+	// doesn't exist
+	GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*GetStatementResponse, error)
+	// ExportStatement renders the statement of the account for the period as a file for banks: an ISO 20022
+	// camt.053 (version 001.02) XML document or a SWIFT MT940 message. The account is identified by its IBAN
+	// or by its number if it has none. Both ends of the period are required. The same files are served
+	// for download over HTTP at /statements?account_number=...&from_date=...&to_date=...&format=camt_053|mt940
+	ExportStatement(ctx context.Context, in *ExportStatementRequest, opts ...grpc.CallOption) (*ExportStatementResponse, error)
+	// Transfer moves an amount from one account to another, the withdrawal and the deposit
+	// are recorded atomically and share the same transfer id. Both accounts must have the same currency
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	// ReverseTransaction cancels out a transaction by writing a compensating transaction of the opposite type
+	// that references the original one, reversing a leg of a transfer reverses the whole transfer.
+	// Fails with NOT_FOUND if the transaction doesn't exist and with FAILED_PRECONDITION if it is already
+	// reversed, is a reversal itself or reversing a deposit would take the balance below the overdraft limit
+	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReverseTransactionResponse, error)
+	// GetTransactionProof returns the cryptographic proof from Vault that the transaction is in the ledger.
+	// The proof is verified against the trusted state of the ledger before it is returned, it fails with
+	// DATA_LOSS if the proof doesn't verify, with UNAVAILABLE if Vault can't be reached or returns a proof
+	// that can't be decoded and with UNIMPLEMENTED if the storage isn't Vault.
+	// The proof can be verified offline with cmd/verifyproof
+	GetTransactionProof(ctx context.Context, in *GetTransactionProofRequest, opts ...grpc.CallOption) (*GetTransactionProofResponse, error)
 }
 
 type accountServiceClient struct {
@@ -52,6 +128,15 @@ func (c *accountServiceClient) ListAccounts(ctx context.Context, in *ListAccount
 	return out, nil
 }
 
+func (c *accountServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
 	out := new(ListTransactionsResponse)
 	err := c.cc.Invoke(ctx, AccountService_ListTransactions_FullMethodName, in, out, opts...)
@@ -61,6 +146,70 @@ func (c *accountServiceClient) ListTransactions(ctx context.Context, in *ListTra
 	return out, nil
 }
 
+func (c *accountServiceClient) ExportTransactions(ctx context.Context, in *ExportTransactionsRequest, opts ...grpc.CallOption) (AccountService_ExportTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AccountService_ServiceDesc.Streams[0], AccountService_ExportTransactions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountServiceExportTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AccountService_ExportTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type accountServiceExportTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountServiceExportTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *accountServiceClient) WatchTransactions(ctx context.Context, in *WatchTransactionsRequest, opts ...grpc.CallOption) (AccountService_WatchTransactionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AccountService_ServiceDesc.Streams[1], AccountService_WatchTransactions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &accountServiceWatchTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AccountService_WatchTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type accountServiceWatchTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *accountServiceWatchTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *accountServiceClient) CreateAccount(ctx context.Context, in *Account, opts ...grpc.CallOption) (*CreateAccountResponse, error) {
 	out := new(CreateAccountResponse)
 	err := c.cc.Invoke(ctx, AccountService_CreateAccount_FullMethodName, in, out, opts...)
@@ -70,6 +219,24 @@ func (c *accountServiceClient) CreateAccount(ctx context.Context, in *Account, o
 	return out, nil
 }
 
+func (c *accountServiceClient) UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error) {
+	out := new(UpdateAccountResponse)
+	err := c.cc.Invoke(ctx, AccountService_UpdateAccount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccountHistory(ctx context.Context, in *GetAccountHistoryRequest, opts ...grpc.CallOption) (*GetAccountHistoryResponse, error) {
+	out := new(GetAccountHistoryResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccountHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *accountServiceClient) CreateTransaction(ctx context.Context, in *Transaction, opts ...grpc.CallOption) (*CreateTransactionResponse, error) {
 	out := new(CreateTransactionResponse)
 	err := c.cc.Invoke(ctx, AccountService_CreateTransaction_FullMethodName, in, out, opts...)
@@ -79,14 +246,141 @@ func (c *accountServiceClient) CreateTransaction(ctx context.Context, in *Transa
 	return out, nil
 }
 
+func (c *accountServiceClient) CreateTransactions(ctx context.Context, in *CreateTransactionsRequest, opts ...grpc.CallOption) (*CreateTransactionsResponse, error) {
+	out := new(CreateTransactionsResponse)
+	err := c.cc.Invoke(ctx, AccountService_CreateTransactions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetAccountBalance(ctx context.Context, in *GetAccountBalanceRequest, opts ...grpc.CallOption) (*GetAccountBalanceResponse, error) {
+	out := new(GetAccountBalanceResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetAccountBalance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (*GetStatementResponse, error) {
+	out := new(GetStatementResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ExportStatement(ctx context.Context, in *ExportStatementRequest, opts ...grpc.CallOption) (*ExportStatementResponse, error) {
+	out := new(ExportStatementResponse)
+	err := c.cc.Invoke(ctx, AccountService_ExportStatement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, AccountService_Transfer_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReverseTransactionResponse, error) {
+	out := new(ReverseTransactionResponse)
+	err := c.cc.Invoke(ctx, AccountService_ReverseTransaction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountServiceClient) GetTransactionProof(ctx context.Context, in *GetTransactionProofRequest, opts ...grpc.CallOption) (*GetTransactionProofResponse, error) {
+	out := new(GetTransactionProofResponse)
+	err := c.cc.Invoke(ctx, AccountService_GetTransactionProof_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccountServiceServer is the server API for AccountService service.
 // All implementations must embed UnimplementedAccountServiceServer
 // for forward compatibility
 type AccountServiceServer interface {
+	// ListAccounts returns a list of accounts. The accounts can be filtered and sorted by number, name, address,
+	// iban, currency and overdraft_limit
 	ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error)
+	// GetAccount returns the account with its balance, the account is looked up by the id, the number or the IBAN.
+	// Fails with NOT_FOUND if there is no such account
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	// ListTransactions returns a list of transactions for a given account. The transactions can be filtered
+	// and sorted by amount, type, counterparty_account_number, transfer_id, currency, reversed_transaction_id,
+	// created_at, value_date and reference. created_at is compared with RFC 3339 timestamps or YYYY-MM-DD dates (midnight UTC),
+	// e.g. created_at GE "2024-01-01" and created_at LT "2024-02-01" select January
 	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	// ExportTransactions streams all the transactions of the account, it reads them page by page from a single
+	// Vault search, so the export is consistent even while transactions are written. The transactions can be
+	// filtered and sorted as in ListTransactions. Fails with NOT_FOUND if the account doesn't exist
+	ExportTransactions(*ExportTransactionsRequest, AccountService_ExportTransactionsServer) error
+	// WatchTransactions streams the transactions created from now on, of the account or of all the accounts
+	// if account_number is empty. The transactions created by this instance arrive right away, the ones created
+	// by other instances arrive once Vault is polled for them. Fails with RESOURCE_EXHAUSTED if the client
+	// falls too far behind, the transactions created meanwhile can be read with ListTransactions
+	WatchTransactions(*WatchTransactionsRequest, AccountService_WatchTransactionsServer) error
+	// CreateAccount creates a new account. Retries are made safe by sending an idempotency key in the idempotency-key
+	// metadata: a replay with the same key returns the id of the account created by the first request,
+	// a request with the same key and a different account fails with FAILED_PRECONDITION
 	CreateAccount(context.Context, *Account) (*CreateAccountResponse, error)
+	// UpdateAccount changes the name, address and IBAN of the account, the other fields can't be changed.
+	// The actor metadata tells who makes the change, it is recorded with the account and is required.
+	// Fails with NOT_FOUND if the account doesn't exist
+	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	// GetAccountHistory returns every revision of the account with the Vault transaction that wrote it
+	// and the field-level diffs between the revisions. Fails with UNIMPLEMENTED if the storage isn't Vault
+	GetAccountHistory(context.Context, *GetAccountHistoryRequest) (*GetAccountHistoryResponse, error)
+	// CreateTransaction creates a new transaction for a given account. It takes an idempotency key the same way
+	// as CreateAccount, a replay returns the id of the recorded transaction without checking the balance again.
+	// Fails with NOT_FOUND if the account doesn't exist and with FAILED_PRECONDITION if the currency
+	// doesn't match the account or a withdrawal would take the balance below the overdraft limit of the account
 	CreateTransaction(context.Context, *Transaction) (*CreateTransactionResponse, error)
+	// CreateTransactions creates up to VAULT_MAXBATCHSIZE transactions in a single write to Vault. Every transaction
+	// is checked as in CreateTransaction, the withdrawals against the balance including the transactions before them
+	// in the request. The results follow the order of the transactions: the id of the created transaction
+	// or the status code and the message of the failure. With all_or_nothing no transaction is created if any fails,
+	// the others are reported as ABORTED. Idempotency keys aren't supported, a request with the idempotency-key metadata
+	// fails with INVALID_ARGUMENT. A failed all or nothing request can be retried
+	CreateTransactions(context.Context, *CreateTransactionsRequest) (*CreateTransactionsResponse, error)
+	// GetAccountBalance returns the current balance of a given account
+	GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error)
+	// GetStatement returns the transactions of the account recorded within the period with the running balance,
+	// the opening and the closing balance and the totals of the period. Fails with NOT_FOUND if the account
+	// doesn't exist
+	GetStatement(context.Context, *GetStatementRequest) (*GetStatementResponse, error)
+	// ExportStatement renders the statement of the account for the period as a file for banks: an ISO 20022
+	// camt.053 (version 001.02) XML document or a SWIFT MT940 message. The account is identified by its IBAN
+	// or by its number if it has none. Both ends of the period are required. The same files are served
+	// for download over HTTP at /statements?account_number=...&from_date=...&to_date=...&format=camt_053|mt940
+	ExportStatement(context.Context, *ExportStatementRequest) (*ExportStatementResponse, error)
+	// Transfer moves an amount from one account to another, the withdrawal and the deposit
+	// are recorded atomically and share the same transfer id. Both accounts must have the same currency
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	// ReverseTransaction cancels out a transaction by writing a compensating transaction of the opposite type
+	// that references the original one, reversing a leg of a transfer reverses the whole transfer.
+	// Fails with NOT_FOUND if the transaction doesn't exist and with FAILED_PRECONDITION if it is already
+	// reversed, is a reversal itself or reversing a deposit would take the balance below the overdraft limit
+	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReverseTransactionResponse, error)
+	// GetTransactionProof returns the cryptographic proof from Vault that the transaction is in the ledger.
+	// The proof is verified against the trusted state of the ledger before it is returned, it fails with
+	// DATA_LOSS if the proof doesn't verify, with UNAVAILABLE if Vault can't be reached or returns a proof
+	// that can't be decoded and with UNIMPLEMENTED if the storage isn't Vault.
+	// The proof can be verified offline with cmd/verifyproof
+	GetTransactionProof(context.Context, *GetTransactionProofRequest) (*GetTransactionProofResponse, error)
 	mustEmbedUnimplementedAccountServiceServer()
 }
 
@@ -97,15 +391,51 @@ type UnimplementedAccountServiceServer struct {
 func (UnimplementedAccountServiceServer) ListAccounts(context.Context, *ListAccountsRequest) (*ListAccountsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAccounts not implemented")
 }
+func (UnimplementedAccountServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
+}
 func (UnimplementedAccountServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
 }
+func (UnimplementedAccountServiceServer) ExportTransactions(*ExportTransactionsRequest, AccountService_ExportTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportTransactions not implemented")
+}
+func (UnimplementedAccountServiceServer) WatchTransactions(*WatchTransactionsRequest, AccountService_WatchTransactionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTransactions not implemented")
+}
 func (UnimplementedAccountServiceServer) CreateAccount(context.Context, *Account) (*CreateAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
 }
+func (UnimplementedAccountServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAccountHistory(context.Context, *GetAccountHistoryRequest) (*GetAccountHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountHistory not implemented")
+}
 func (UnimplementedAccountServiceServer) CreateTransaction(context.Context, *Transaction) (*CreateTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
 }
+func (UnimplementedAccountServiceServer) CreateTransactions(context.Context, *CreateTransactionsRequest) (*CreateTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransactions not implemented")
+}
+func (UnimplementedAccountServiceServer) GetAccountBalance(context.Context, *GetAccountBalanceRequest) (*GetAccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalance not implemented")
+}
+func (UnimplementedAccountServiceServer) GetStatement(context.Context, *GetStatementRequest) (*GetStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedAccountServiceServer) ExportStatement(context.Context, *ExportStatementRequest) (*ExportStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportStatement not implemented")
+}
+func (UnimplementedAccountServiceServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedAccountServiceServer) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReverseTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransaction not implemented")
+}
+func (UnimplementedAccountServiceServer) GetTransactionProof(context.Context, *GetTransactionProofRequest) (*GetTransactionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionProof not implemented")
+}
 func (UnimplementedAccountServiceServer) mustEmbedUnimplementedAccountServiceServer() {}
 
 // UnsafeAccountServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -137,6 +467,24 @@ func _AccountService_ListAccounts_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_GetAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListTransactionsRequest)
 	if err := dec(in); err != nil {
@@ -155,6 +503,48 @@ func _AccountService_ListTransactions_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_ExportTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountServiceServer).ExportTransactions(m, &accountServiceExportTransactionsServer{stream})
+}
+
+type AccountService_ExportTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type accountServiceExportTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountServiceExportTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AccountService_WatchTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTransactionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AccountServiceServer).WatchTransactions(m, &accountServiceWatchTransactionsServer{stream})
+}
+
+type AccountService_WatchTransactionsServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
+}
+
+type accountServiceWatchTransactionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *accountServiceWatchTransactionsServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _AccountService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Account)
 	if err := dec(in); err != nil {
@@ -173,6 +563,42 @@ func _AccountService_CreateAccount_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_UpdateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).UpdateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_UpdateAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).UpdateAccount(ctx, req.(*UpdateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountHistory(ctx, req.(*GetAccountHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AccountService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Transaction)
 	if err := dec(in); err != nil {
@@ -191,6 +617,132 @@ func _AccountService_CreateTransaction_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccountService_CreateTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).CreateTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_CreateTransactions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).CreateTransactions(ctx, req.(*CreateTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetAccountBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetAccountBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetAccountBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetAccountBalance(ctx, req.(*GetAccountBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetStatement(ctx, req.(*GetStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ExportStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ExportStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ExportStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ExportStatement(ctx, req.(*ExportStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).ReverseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_ReverseTransaction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).ReverseTransaction(ctx, req.(*ReverseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountService_GetTransactionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountServiceServer).GetTransactionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AccountService_GetTransactionProof_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountServiceServer).GetTransactionProof(ctx, req.(*GetTransactionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AccountService_ServiceDesc is the grpc.ServiceDesc for AccountService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +754,10 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListAccounts",
 			Handler:    _AccountService_ListAccounts_Handler,
 		},
+		{
+			MethodName: "GetAccount",
+			Handler:    _AccountService_GetAccount_Handler,
+		},
 		{
 			MethodName: "ListTransactions",
 			Handler:    _AccountService_ListTransactions_Handler,
@@ -210,11 +766,58 @@ var AccountService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateAccount",
 			Handler:    _AccountService_CreateAccount_Handler,
 		},
+		{
+			MethodName: "UpdateAccount",
+			Handler:    _AccountService_UpdateAccount_Handler,
+		},
+		{
+			MethodName: "GetAccountHistory",
+			Handler:    _AccountService_GetAccountHistory_Handler,
+		},
 		{
 			MethodName: "CreateTransaction",
 			Handler:    _AccountService_CreateTransaction_Handler,
 		},
+		{
+			MethodName: "CreateTransactions",
+			Handler:    _AccountService_CreateTransactions_Handler,
+		},
+		{
+			MethodName: "GetAccountBalance",
+			Handler:    _AccountService_GetAccountBalance_Handler,
+		},
+		{
+			MethodName: "GetStatement",
+			Handler:    _AccountService_GetStatement_Handler,
+		},
+		{
+			MethodName: "ExportStatement",
+			Handler:    _AccountService_ExportStatement_Handler,
+		},
+		{
+			MethodName: "Transfer",
+			Handler:    _AccountService_Transfer_Handler,
+		},
+		{
+			MethodName: "ReverseTransaction",
+			Handler:    _AccountService_ReverseTransaction_Handler,
+		},
+		{
+			MethodName: "GetTransactionProof",
+			Handler:    _AccountService_GetTransactionProof_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportTransactions",
+			Handler:       _AccountService_ExportTransactions_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchTransactions",
+			Handler:       _AccountService_WatchTransactions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/accountservice.proto",
 }
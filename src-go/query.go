@@ -0,0 +1,311 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ListQuery narrows down and orders a list of records, the records are listed in the order they were created
+// unless OrderBy is set
+type ListQuery struct {
+	// Filters must all match
+	Filters []Filter
+	OrderBy []SortField
+}
+
+// Filter compares a field of the records with a value
+type Filter struct {
+	Field    string
+	Operator FilterOperator
+	// Value is a string or an int64 depending on the field
+	Value any
+}
+
+type FilterOperator string
+
+// The operators are the ones of Vault queries
+const (
+	FilterEQ FilterOperator = "EQ"
+	FilterNE FilterOperator = "NE"
+	FilterLT FilterOperator = "LT"
+	FilterLE FilterOperator = "LE"
+	FilterGT FilterOperator = "GT"
+	FilterGE FilterOperator = "GE"
+	// FilterLike matches string fields against a regular expression
+	FilterLike FilterOperator = "LIKE"
+)
+
+type SortField struct {
+	Field string
+	Desc  bool
+}
+
+type fieldKind int
+
+const (
+	stringField fieldKind = iota
+	integerField
+	// timestampField is a string in TimestampLayout, it is compared with RFC 3339 timestamps or dates
+	timestampField
+	// dateField is a string in DateLayout
+	dateField
+)
+
+// accountFields are the fields the accounts can be filtered and sorted by, they are in the schema of the collection
+var accountFields = map[string]fieldKind{
+	"number":          stringField,
+	"name":            stringField,
+	"address":         stringField,
+	"iban":            stringField,
+	"currency":        stringField,
+	"overdraft_limit": integerField,
+}
+
+// transactionFields are the fields the transactions can be filtered and sorted by, they are in the schema
+// of the collection
+var transactionFields = map[string]fieldKind{
+	"amount":                      integerField,
+	"type":                        stringField,
+	"counterparty_account_number": stringField,
+	"transfer_id":                 stringField,
+	"currency":                    stringField,
+	"reversed_transaction_id":     stringField,
+	"created_at":                  timestampField,
+	"value_date":                  dateField,
+	"reference":                   stringField,
+}
+
+// check validates the query against the fields of the records and returns it with the values converted
+// to the format of the storages. InvalidInputError is returned for unknown fields and operators
+// and for values of the wrong type
+func (q ListQuery) check(fields map[string]fieldKind) (ListQuery, error) {
+	checked := ListQuery{Filters: make([]Filter, 0, len(q.Filters)), OrderBy: q.OrderBy}
+	for _, f := range q.Filters {
+		kind, ok := fields[f.Field]
+		if !ok {
+			return ListQuery{}, fmt.Errorf("%w: can't filter by field %q", InvalidInputError, f.Field)
+		}
+		switch f.Operator {
+		case FilterEQ, FilterNE, FilterLT, FilterLE, FilterGT, FilterGE:
+		case FilterLike:
+			if kind != stringField {
+				return ListQuery{}, fmt.Errorf("%w: LIKE only applies to string fields, %s isn't one", InvalidInputError, f.Field)
+			}
+			pattern, _ := f.Value.(string)
+			if _, err := regexp.Compile(pattern); err != nil {
+				return ListQuery{}, fmt.Errorf("%w: bad LIKE pattern for %s: %s", InvalidInputError, f.Field, err)
+			}
+		default:
+			return ListQuery{}, fmt.Errorf("%w: unknown operator %q", InvalidInputError, f.Operator)
+		}
+		value, err := checkValue(kind, f.Field, f.Value)
+		if err != nil {
+			return ListQuery{}, err
+		}
+		checked.Filters = append(checked.Filters, Filter{Field: f.Field, Operator: f.Operator, Value: value})
+	}
+	for _, s := range q.OrderBy {
+		if _, ok := fields[s.Field]; !ok {
+			return ListQuery{}, fmt.Errorf("%w: can't sort by field %q", InvalidInputError, s.Field)
+		}
+	}
+	return checked, nil
+}
+
+// checkValue checks the type of the value and converts timestamps and dates to the layouts of the storages
+func checkValue(kind fieldKind, field string, value any) (any, error) {
+	switch value := value.(type) {
+	case string:
+		switch kind {
+		case stringField:
+			return value, nil
+		case timestampField:
+			if t, err := time.Parse(time.RFC3339Nano, value); err == nil {
+				return FormatTimestamp(t), nil
+			}
+			// a date stands for its midnight in UTC
+			if t, err := time.Parse(DateLayout, value); err == nil {
+				return FormatTimestamp(t), nil
+			}
+			return nil, fmt.Errorf("%w: %s must be compared with an RFC 3339 timestamp or a YYYY-MM-DD date", InvalidInputError, field)
+		case dateField:
+			if _, err := time.Parse(DateLayout, value); err != nil {
+				return nil, fmt.Errorf("%w: %s must be compared with a YYYY-MM-DD date", InvalidInputError, field)
+			}
+			return value, nil
+		}
+		return nil, fmt.Errorf("%w: %s must be compared with an integer", InvalidInputError, field)
+	case int64:
+		if kind != integerField {
+			return nil, fmt.Errorf("%w: %s must be compared with a string", InvalidInputError, field)
+		}
+		return value, nil
+	}
+	return nil, fmt.Errorf("%w: bad value %v for %s", InvalidInputError, value, field)
+}
+
+// vaultQuery adds the filters and the order of the query to the base comparisons, nil is returned
+// if there is nothing to query by
+func (q ListQuery) vaultQuery(base ...FieldComparison) *Query {
+	if len(base) == 0 && len(q.Filters) == 0 && len(q.OrderBy) == 0 {
+		return nil
+	}
+	comparisons := append([]FieldComparison{}, base...)
+	for _, f := range q.Filters {
+		comparisons = append(comparisons, FieldComparison{Field: f.Field, Operator: Operator(f.Operator), Value: f.Value})
+	}
+	query := &Query{}
+	if len(comparisons) > 0 {
+		query.Expressions = &[]QueryExpression{{FieldComparisons: &comparisons}}
+	}
+	if len(q.OrderBy) > 0 {
+		orderBy := make([]OrderBy, 0, len(q.OrderBy))
+		for _, s := range q.OrderBy {
+			orderBy = append(orderBy, OrderBy{Field: s.Field, Desc: s.Desc})
+		}
+		query.OrderBy = &orderBy
+	}
+	return query
+}
+
+// sqlWhere returns the conditions of the filters joined with AND and their arguments,
+// the field names are checked beforehand, so they are safe to use as column names
+func (q ListQuery) sqlWhere() (string, []any) {
+	conditions := make([]string, 0, len(q.Filters))
+	args := make([]any, 0, len(q.Filters))
+	for _, f := range q.Filters {
+		switch f.Operator {
+		case FilterLike:
+			conditions = append(conditions, f.Field+" REGEXP ?")
+		default:
+			conditions = append(conditions, f.Field+" "+sqlOperators[f.Operator]+" ?")
+		}
+		args = append(args, f.Value)
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+var sqlOperators = map[FilterOperator]string{
+	FilterEQ: "=", FilterNE: "!=", FilterLT: "<", FilterLE: "<=", FilterGT: ">", FilterGE: ">=",
+}
+
+// sqlOrderBy returns the ORDER BY terms of the query, the creation order breaks the ties
+func (q ListQuery) sqlOrderBy() string {
+	terms := make([]string, 0, len(q.OrderBy)+1)
+	for _, s := range q.OrderBy {
+		if s.Desc {
+			terms = append(terms, s.Field+" DESC")
+		} else {
+			terms = append(terms, s.Field)
+		}
+	}
+	return strings.Join(append(terms, "seq"), ", ")
+}
+
+// filterRecords returns the records matching the filters in the order of the query,
+// the records are compared by the values of their JSON fields
+func filterRecords[T AccountRecord | TransactionRecord](records []T, q ListQuery) ([]T, error) {
+	type entry struct {
+		record T
+		fields map[string]any
+	}
+	var entries []entry
+	for _, r := range records {
+		fields, err := recordFields(r)
+		if err != nil {
+			return nil, err
+		}
+		if matchFilters(fields, q.Filters) {
+			entries = append(entries, entry{r, fields})
+		}
+	}
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		for _, s := range q.OrderBy {
+			cmp := compareValues(a.fields[s.Field], b.fields[s.Field])
+			if s.Desc {
+				cmp = -cmp
+			}
+			if cmp != 0 {
+				return cmp
+			}
+		}
+		return 0
+	})
+	filtered := make([]T, 0, len(entries))
+	for _, e := range entries {
+		filtered = append(filtered, e.record)
+	}
+	return filtered, nil
+}
+
+func matchFilters(fields map[string]any, filters []Filter) bool {
+	for _, f := range filters {
+		value := fields[f.Field]
+		var ok bool
+		switch f.Operator {
+		case FilterEQ:
+			ok = compareValues(value, f.Value) == 0
+		case FilterNE:
+			ok = compareValues(value, f.Value) != 0
+		case FilterLT:
+			ok = compareValues(value, f.Value) < 0
+		case FilterLE:
+			ok = compareValues(value, f.Value) <= 0
+		case FilterGT:
+			ok = compareValues(value, f.Value) > 0
+		case FilterGE:
+			ok = compareValues(value, f.Value) >= 0
+		case FilterLike:
+			s, _ := value.(string)
+			ok = regexp.MustCompile(f.Value.(string)).MatchString(s)
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// compareValues orders strings and numbers, the fields of the records are either one or the other
+func compareValues(a, b any) int {
+	if as, ok := a.(string); ok {
+		return strings.Compare(as, fmt.Sprint(b))
+	}
+	af, bf := toFloat(a), toFloat(b)
+	switch {
+	case af < bf:
+		return -1
+	case af > bf:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func toFloat(v any) float64 {
+	switch v := v.(type) {
+	case float64:
+		return v
+	case int64:
+		return float64(v)
+	}
+	return 0
+}
+
+// recordFields returns the fields of the record as they are stored in a document
+func recordFields(record any) (map[string]any, error) {
+	jstr, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling record: %w", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(jstr, &fields); err != nil {
+		return nil, fmt.Errorf("error unmarshalling record: %w", err)
+	}
+	return fields, nil
+}
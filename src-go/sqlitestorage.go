@@ -0,0 +1,535 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+var _ Storage = (*SqliteStorage)(nil)
+
+// SqliteStorage is a storage that keeps the models in an embedded SQLite database,
+// it is meant for self-hosted deployments without Vault
+type SqliteStorage struct {
+	db *sql.DB
+}
+
+type SqliteConfig struct {
+	Path string `default:"ledger.db"`
+}
+
+func init() {
+	// REGEXP backs the LIKE filters, which match regular expressions as in Vault
+	sqlite.MustRegisterDeterministicScalarFunction("regexp", 2,
+		func(_ *sqlite.FunctionContext, args []driver.Value) (driver.Value, error) {
+			pattern, _ := args[0].(string)
+			value, _ := args[1].(string)
+			return regexp.MatchString(pattern, value)
+		},
+	)
+}
+
+func NewSqliteStorage(config SqliteConfig) (*SqliteStorage, error) {
+	dsn := fmt.Sprintf("file:%s?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", config.Path)
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error opening sqlite database: %w", err)
+	}
+	return &SqliteStorage{db}, nil
+}
+
+func (s *SqliteStorage) ListAccounts(ctx context.Context, query ListQuery, pageSize int, pageNumber int) ([]AccountRecord, int, error) {
+	if err := checkPage(pageSize, pageNumber); err != nil {
+		return nil, 0, err
+	}
+	query, err := query.check(accountFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	where, args := query.sqlWhere()
+	if where != "" {
+		where = " WHERE " + where
+	}
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT "+accountColumns+" FROM accounts"+where+" ORDER BY "+query.sqlOrderBy()+" LIMIT ? OFFSET ?",
+		append(args, pageSize, (pageNumber-1)*pageSize)...,
+	)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error selecting accounts: %w", err)
+	}
+	defer rows.Close()
+
+	var accounts []AccountRecord
+	for rows.Next() {
+		a, err := scanAccount(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error scanning account: %w", err)
+		}
+		accounts = append(accounts, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error selecting accounts: %w", err)
+	}
+
+	var count int
+	if err := s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM accounts"+where, args...).Scan(&count); err != nil {
+		return nil, 0, fmt.Errorf("error counting accounts: %w", err)
+	}
+	return accounts, count, nil
+}
+
+func (s *SqliteStorage) GetAccount(ctx context.Context, number string) (AccountRecord, error) {
+	return s.findAccount(ctx, "number = ?", number)
+}
+
+func (s *SqliteStorage) GetAccountById(ctx context.Context, id string) (AccountRecord, error) {
+	return s.findAccount(ctx, "id = ?", id)
+}
+
+func (s *SqliteStorage) GetAccountByIBAN(ctx context.Context, iban string) (AccountRecord, error) {
+	return s.findAccount(ctx, "iban = ?", iban)
+}
+
+func (s *SqliteStorage) GetAccountByIdempotencyKey(ctx context.Context, key string) (AccountRecord, error) {
+	return s.findAccount(ctx, "idempotency_key = ? AND idempotency_key != ''", key)
+}
+
+// findAccount returns the first account matching the `where` condition
+func (s *SqliteStorage) findAccount(ctx context.Context, where string, args ...any) (AccountRecord, error) {
+	a, err := scanAccount(s.db.QueryRowContext(ctx,
+		"SELECT "+accountColumns+" FROM accounts WHERE "+where+" ORDER BY seq LIMIT 1", args...,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return AccountRecord{}, NotFoundError
+	}
+	if err != nil {
+		return AccountRecord{}, fmt.Errorf("error selecting account: %w", err)
+	}
+	return a, nil
+}
+
+func (s *SqliteStorage) ListTransactions(ctx context.Context, accountNumber string, query ListQuery, pageSize int, pageNumber int) ([]TransactionRecord, int, error) {
+	if err := checkPage(pageSize, pageNumber); err != nil {
+		return nil, 0, err
+	}
+	query, err := query.check(transactionFields)
+	if err != nil {
+		return nil, 0, err
+	}
+	where, args := query.sqlWhere()
+	if where != "" {
+		where = " AND " + where
+	}
+	args = append([]any{accountNumber}, args...)
+	transactions, err := s.queryTransactions(ctx,
+		"WHERE account_number = ?"+where+" ORDER BY "+query.sqlOrderBy()+" LIMIT ? OFFSET ?",
+		append(args, pageSize, (pageNumber-1)*pageSize)...,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var count int
+	err = s.db.QueryRowContext(ctx, "SELECT COUNT(*) FROM transactions WHERE account_number = ?"+where, args...).Scan(&count)
+	if err != nil {
+		return nil, 0, fmt.Errorf("error counting transactions: %w", err)
+	}
+	return transactions, count, nil
+}
+
+// TransactionsCursor returns the sequence number of the newest transaction, SQLite writes one transaction
+// at a time, so the rows are committed in the order of their sequence numbers
+func (s *SqliteStorage) TransactionsCursor(ctx context.Context) (string, error) {
+	var seq int64
+	if err := s.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(seq), 0) FROM transactions").Scan(&seq); err != nil {
+		return "", fmt.Errorf("error selecting the last transaction: %w", err)
+	}
+	return strconv.FormatInt(seq, 10), nil
+}
+
+func (s *SqliteStorage) ListTransactionsAfter(ctx context.Context, cursor string, pageSize int, pageNumber int) ([]TransactionRecord, string, error) {
+	if err := checkPage(pageSize, pageNumber); err != nil {
+		return nil, "", err
+	}
+	seq, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil && cursor != "" {
+		return nil, "", fmt.Errorf("%w: bad cursor %q", InvalidInputError, cursor)
+	}
+	transactions, err := s.queryTransactions(ctx,
+		"WHERE seq > ? ORDER BY seq LIMIT ? OFFSET ?", seq, pageSize, (pageNumber-1)*pageSize,
+	)
+	if err != nil || len(transactions) == 0 {
+		return transactions, cursor, err
+	}
+	err = s.db.QueryRowContext(ctx, "SELECT seq FROM transactions WHERE id = ?", transactions[len(transactions)-1].Id).Scan(&seq)
+	if err != nil {
+		return nil, "", fmt.Errorf("error selecting the sequence number of a transaction: %w", err)
+	}
+	return transactions, strconv.FormatInt(seq, 10), nil
+}
+
+func (s *SqliteStorage) GetTransaction(ctx context.Context, id string) (TransactionRecord, error) {
+	return s.findTransaction(ctx, "id = ?", id)
+}
+
+func (s *SqliteStorage) GetTransactionByIdempotencyKey(ctx context.Context, key string) (TransactionRecord, error) {
+	return s.findTransaction(ctx, "idempotency_key = ? AND idempotency_key != ''", key)
+}
+
+// findTransaction returns the first transaction matching the `where` condition
+func (s *SqliteStorage) findTransaction(ctx context.Context, where string, args ...any) (TransactionRecord, error) {
+	t, err := scanTransaction(s.db.QueryRowContext(ctx,
+		"SELECT "+transactionColumns+" FROM transactions WHERE "+where+" ORDER BY seq LIMIT 1", args...,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return TransactionRecord{}, NotFoundError
+	}
+	if err != nil {
+		return TransactionRecord{}, fmt.Errorf("error selecting transaction: %w", err)
+	}
+	return t, nil
+}
+
+func (s *SqliteStorage) GetTransferTransactions(ctx context.Context, transferId string) ([]TransactionRecord, error) {
+	return s.queryTransactions(ctx, "WHERE transfer_id = ? ORDER BY seq", transferId)
+}
+
+func (s *SqliteStorage) GetReversals(ctx context.Context, transactionIds []string) ([]TransactionRecord, error) {
+	if len(transactionIds) == 0 {
+		return nil, nil
+	}
+	args := make([]any, 0, len(transactionIds))
+	for _, id := range transactionIds {
+		args = append(args, id)
+	}
+	placeholders := strings.Repeat("?, ", len(args)-1) + "?"
+	return s.queryTransactions(ctx, "WHERE reversed_transaction_id IN ("+placeholders+") ORDER BY seq", args...)
+}
+
+// queryTransactions selects the transactions matching the `where` clause, which may also contain ORDER BY and LIMIT
+func (s *SqliteStorage) queryTransactions(ctx context.Context, where string, args ...any) ([]TransactionRecord, error) {
+	rows, err := s.db.QueryContext(ctx, "SELECT "+transactionColumns+" FROM transactions "+where, args...)
+	if err != nil {
+		return nil, fmt.Errorf("error selecting transactions: %w", err)
+	}
+	defer rows.Close()
+
+	var transactions []TransactionRecord
+	for rows.Next() {
+		t, err := scanTransaction(rows)
+		if err != nil {
+			return nil, fmt.Errorf("error scanning transaction: %w", err)
+		}
+		transactions = append(transactions, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error selecting transactions: %w", err)
+	}
+	return transactions, nil
+}
+
+func (s *SqliteStorage) GetBalance(ctx context.Context, accountNumber string) (int64, error) {
+	var balance int64
+	err := s.db.QueryRowContext(ctx,
+		"SELECT COALESCE(SUM(CASE WHEN type = ? THEN -amount ELSE amount END), 0) FROM transactions WHERE account_number = ?",
+		WithdrawalType, accountNumber,
+	).Scan(&balance)
+	if err != nil {
+		return 0, fmt.Errorf("error summing transactions: %w", err)
+	}
+	return balance, nil
+}
+
+// GetBalanceBefore counts in the rows created before the timestamps, their empty created_at goes first
+func (s *SqliteStorage) GetBalanceBefore(ctx context.Context, accountNumber string, before string) (int64, error) {
+	var balance int64
+	err := s.db.QueryRowContext(ctx,
+		"SELECT COALESCE(SUM(CASE WHEN type = ? THEN -amount ELSE amount END), 0) FROM transactions "+
+			"WHERE account_number = ? AND created_at < ?",
+		WithdrawalType, accountNumber, before,
+	).Scan(&balance)
+	if err != nil {
+		return 0, fmt.Errorf("error summing transactions: %w", err)
+	}
+	return balance, nil
+}
+
+func (s *SqliteStorage) GetBalances(ctx context.Context, accountNumbers []string) (map[string]int64, error) {
+	states, err := s.GetBalanceStates(ctx, accountNumbers)
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[string]int64, len(states))
+	for number, state := range states {
+		balances[number] = state.Balance
+	}
+	return balances, nil
+}
+
+// GetBalanceStates sums up all the accounts with a single statement
+func (s *SqliteStorage) GetBalanceStates(ctx context.Context, accountNumbers []string) (map[string]BalanceState, error) {
+	balances := make(map[string]BalanceState, len(accountNumbers))
+	if len(accountNumbers) == 0 {
+		return balances, nil
+	}
+	args := []any{WithdrawalType}
+	for _, number := range accountNumbers {
+		balances[number] = BalanceState{}
+		args = append(args, number)
+	}
+	placeholders := strings.Repeat("?, ", len(accountNumbers)-1) + "?"
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT account_number, SUM(CASE WHEN type = ? THEN -amount ELSE amount END), MAX(sequence) FROM transactions "+
+			"WHERE account_number IN ("+placeholders+") GROUP BY account_number",
+		args...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error summing transactions: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var number string
+		var state BalanceState
+		if err := rows.Scan(&number, &state.Balance, &state.Sequence); err != nil {
+			return nil, fmt.Errorf("error scanning balance: %w", err)
+		}
+		balances[number] = state
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error summing transactions: %w", err)
+	}
+	return balances, nil
+}
+
+func (s *SqliteStorage) AddAccount(ctx context.Context, account AccountRecord) (string, error) {
+	if err := account.Validate(); err != nil {
+		return "", err
+	}
+	account.Id = newDocumentId()
+	_, err := s.db.ExecContext(ctx,
+		"INSERT INTO accounts ("+accountColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		account.Id, account.Number, account.Name, account.Address, account.IBAN, account.OverdraftLimit, account.Currency,
+		account.IdempotencyKey, account.Actor,
+	)
+	if isUniqueViolation(err) {
+		return "", DuplicateKeyError
+	}
+	if err != nil {
+		return "", fmt.Errorf("error inserting account: %w", err)
+	}
+	return account.Id, nil
+}
+
+func (s *SqliteStorage) UpdateAccount(ctx context.Context, account AccountRecord) error {
+	if err := account.Validate(); err != nil {
+		return err
+	}
+	r, err := s.db.ExecContext(ctx,
+		"UPDATE accounts SET name = ?, address = ?, iban = ?, overdraft_limit = ?, currency = ?, actor = ? WHERE number = ?",
+		account.Name, account.Address, account.IBAN, account.OverdraftLimit, account.Currency, account.Actor, account.Number,
+	)
+	if err != nil {
+		return fmt.Errorf("error updating account: %w", err)
+	}
+	if n, err := r.RowsAffected(); err != nil {
+		return fmt.Errorf("error updating account: %w", err)
+	} else if n == 0 {
+		return NotFoundError
+	}
+	return nil
+}
+
+func (s *SqliteStorage) AddTransaction(ctx context.Context, transaction TransactionRecord) (string, error) {
+	if err := transaction.Validate(); err != nil {
+		return "", err
+	}
+	return insertTransaction(ctx, s.db, transaction)
+}
+
+// AddTransactions inserts all transactions in a single database transaction
+func (s *SqliteStorage) AddTransactions(ctx context.Context, transactions []TransactionRecord) ([]string, error) {
+	for _, t := range transactions {
+		if err := t.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error starting transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	var ids []string
+	for _, t := range transactions {
+		id, err := insertTransaction(ctx, tx, t)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("error committing transactions: %w", err)
+	}
+	return ids, nil
+}
+
+// execer is implemented by both *sql.DB and *sql.Tx
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func insertTransaction(ctx context.Context, db execer, transaction TransactionRecord) (string, error) {
+	transaction.Id = newDocumentId()
+	// the metadata is kept as a JSON object
+	metadata, err := json.Marshal(transaction.Metadata)
+	if err != nil {
+		return "", fmt.Errorf("error marshalling metadata: %w", err)
+	}
+	_, err = db.ExecContext(ctx,
+		"INSERT INTO transactions ("+transactionColumns+") VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		transaction.Id, transaction.AccountNumber, transaction.Amount, transaction.Type,
+		transaction.TransferId, transaction.CounterpartyAccountNumber, transaction.Currency,
+		transaction.ReversedTransactionId, transaction.CreatedAt, transaction.ValueDate,
+		transaction.Description, transaction.Reference, metadata, transaction.IdempotencyKey, transaction.Sequence,
+	)
+	// the unique indexes besides the generated id are the ones on the reversed transaction, the idempotency key
+	// and the sequence
+	if isUniqueViolation(err) {
+		return "", DuplicateKeyError
+	}
+	if err != nil {
+		return "", fmt.Errorf("error inserting transaction: %w", err)
+	}
+	return transaction.Id, nil
+}
+
+// scanner is implemented by both *sql.Row and *sql.Rows
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+const accountColumns = "id, number, name, address, iban, overdraft_limit, currency, idempotency_key, actor"
+
+func scanAccount(row scanner) (AccountRecord, error) {
+	var a AccountRecord
+	err := row.Scan(&a.Id, &a.Number, &a.Name, &a.Address, &a.IBAN, &a.OverdraftLimit, &a.Currency, &a.IdempotencyKey, &a.Actor)
+	return a, err
+}
+
+const transactionColumns = "id, account_number, amount, type, transfer_id, counterparty_account_number, currency, " +
+	"reversed_transaction_id, created_at, value_date, description, reference, metadata, idempotency_key, sequence"
+
+func scanTransaction(row scanner) (TransactionRecord, error) {
+	var t TransactionRecord
+	var metadata []byte
+	err := row.Scan(&t.Id, &t.AccountNumber, &t.Amount, &t.Type,
+		&t.TransferId, &t.CounterpartyAccountNumber, &t.Currency, &t.ReversedTransactionId, &t.CreatedAt, &t.ValueDate,
+		&t.Description, &t.Reference, &metadata, &t.IdempotencyKey, &t.Sequence,
+	)
+	if err != nil {
+		return t, err
+	}
+	if err := json.Unmarshal(metadata, &t.Metadata); err != nil {
+		return t, fmt.Errorf("error unmarshalling metadata of transaction %s: %w", t.Id, err)
+	}
+	return t, nil
+}
+
+// migrations are applied in order by InitCollections, the number of applied ones is kept in user_version
+var migrations = []string{
+	`CREATE TABLE IF NOT EXISTS accounts (
+		seq INTEGER PRIMARY KEY AUTOINCREMENT,
+		id TEXT NOT NULL UNIQUE,
+		number TEXT NOT NULL UNIQUE,
+		name TEXT NOT NULL,
+		address TEXT NOT NULL,
+		iban TEXT NOT NULL
+	);
+	CREATE TABLE IF NOT EXISTS transactions (
+		seq INTEGER PRIMARY KEY AUTOINCREMENT,
+		id TEXT NOT NULL UNIQUE,
+		account_number TEXT NOT NULL,
+		amount INTEGER NOT NULL,
+		type TEXT NOT NULL
+	);
+	CREATE INDEX IF NOT EXISTS transactions_account_number ON transactions (account_number)`,
+
+	`ALTER TABLE accounts ADD COLUMN overdraft_limit INTEGER NOT NULL DEFAULT 0`,
+
+	`ALTER TABLE transactions ADD COLUMN transfer_id TEXT NOT NULL DEFAULT '';
+	ALTER TABLE transactions ADD COLUMN counterparty_account_number TEXT NOT NULL DEFAULT ''`,
+
+	// rows created before currencies have an empty currency, the service treats it as the default one
+	`ALTER TABLE accounts ADD COLUMN currency TEXT NOT NULL DEFAULT '';
+	ALTER TABLE transactions ADD COLUMN currency TEXT NOT NULL DEFAULT ''`,
+
+	`ALTER TABLE transactions ADD COLUMN reversed_transaction_id TEXT NOT NULL DEFAULT '';
+	CREATE UNIQUE INDEX IF NOT EXISTS transactions_reversed_transaction_id ON transactions (reversed_transaction_id)
+		WHERE reversed_transaction_id != '';
+	CREATE INDEX IF NOT EXISTS transactions_transfer_id ON transactions (transfer_id)`,
+
+	`CREATE INDEX IF NOT EXISTS accounts_iban ON accounts (iban) WHERE iban != ''`,
+
+	// rows created before the timestamps have an empty created_at
+	`ALTER TABLE transactions ADD COLUMN created_at TEXT NOT NULL DEFAULT '';
+	ALTER TABLE transactions ADD COLUMN value_date TEXT NOT NULL DEFAULT '';
+	CREATE INDEX IF NOT EXISTS transactions_account_number_created_at ON transactions (account_number, created_at);
+	CREATE INDEX IF NOT EXISTS transactions_account_number_value_date ON transactions (account_number, value_date)`,
+
+	`ALTER TABLE transactions ADD COLUMN description TEXT NOT NULL DEFAULT '';
+	ALTER TABLE transactions ADD COLUMN reference TEXT NOT NULL DEFAULT '';
+	ALTER TABLE transactions ADD COLUMN metadata TEXT NOT NULL DEFAULT 'null';
+	CREATE INDEX IF NOT EXISTS transactions_reference ON transactions (reference) WHERE reference != ''`,
+
+	`ALTER TABLE accounts ADD COLUMN idempotency_key TEXT NOT NULL DEFAULT '';
+	ALTER TABLE transactions ADD COLUMN idempotency_key TEXT NOT NULL DEFAULT '';
+	CREATE UNIQUE INDEX IF NOT EXISTS accounts_idempotency_key ON accounts (idempotency_key) WHERE idempotency_key != '';
+	CREATE UNIQUE INDEX IF NOT EXISTS transactions_idempotency_key ON transactions (idempotency_key)
+		WHERE idempotency_key != ''`,
+
+	`CREATE INDEX IF NOT EXISTS transactions_created_at ON transactions (created_at)`,
+
+	`ALTER TABLE accounts ADD COLUMN actor TEXT NOT NULL DEFAULT ''`,
+
+	`ALTER TABLE transactions ADD COLUMN sequence INTEGER NOT NULL DEFAULT 0;
+	CREATE UNIQUE INDEX IF NOT EXISTS transactions_account_number_sequence ON transactions (account_number, sequence)
+		WHERE sequence != 0`,
+}
+
+// InitCollections creates tables and indexes if they don't exist and migrates them to the latest version
+func (s *SqliteStorage) InitCollections(ctx context.Context) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error starting migration: %w", err)
+	}
+	defer tx.Rollback()
+
+	var version int
+	if err := tx.QueryRowContext(ctx, "PRAGMA user_version").Scan(&version); err != nil {
+		return fmt.Errorf("error getting schema version: %w", err)
+	}
+	for ; version < len(migrations); version++ {
+		if _, err := tx.ExecContext(ctx, migrations[version]); err != nil {
+			return fmt.Errorf("error applying migration %d: %w", version+1, err)
+		}
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("PRAGMA user_version = %d", version)); err != nil {
+		return fmt.Errorf("error setting schema version: %w", err)
+	}
+	return tx.Commit()
+}
+
+func isUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
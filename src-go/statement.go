@@ -0,0 +1,94 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Statement is the activity of an account over a period of days, the transactions are assigned to the days
+// by the time they were recorded at in UTC. The transactions recorded without a time are older than any day,
+// they count into the opening balance
+type Statement struct {
+	Account AccountRecord
+	// From and To are the first and the last day of the period in DateLayout, empty for an open end
+	From           string
+	To             string
+	OpeningBalance int64
+	Lines          []StatementLine
+	// TotalDeposits and TotalWithdrawals are the sums of the amounts of the transactions of the period
+	TotalDeposits    int64
+	TotalWithdrawals int64
+	ClosingBalance   int64
+}
+
+// StatementLine is a transaction of the statement with the balance of the account after it
+type StatementLine struct {
+	Transaction TransactionRecord
+	Balance     int64
+}
+
+func (st *Statement) add(transaction TransactionRecord) {
+	st.ClosingBalance += transaction.SignedAmount()
+	if transaction.Type == WithdrawalType {
+		st.TotalWithdrawals += transaction.Amount
+	} else {
+		st.TotalDeposits += transaction.Amount
+	}
+	st.Lines = append(st.Lines, StatementLine{Transaction: transaction, Balance: st.ClosingBalance})
+}
+
+// statement builds the statement of the account from the days `from` to `to` inclusive, the opening balance
+// is the sum of the transactions recorded before the period and only the transactions of the period are read.
+// The transactions are recorded at the time they are written, so the ones before the period don't change
+// meanwhile and the closing balance of a period up to today is the balance of the account, unless transactions
+// recorded in the past are imported at the same time. InvalidInputError is returned for malformed dates
+func (s *AccountService) statement(ctx context.Context, account AccountRecord, from string, to string) (Statement, error) {
+	statement := Statement{Account: account, From: from, To: to}
+	// start is the first moment of the period, an open start still leaves out the transactions without a time
+	start := FormatTimestamp(time.Time{})
+	if from != "" {
+		first, err := time.Parse(DateLayout, from)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%w: start of the period %q isn't a YYYY-MM-DD date", InvalidInputError, from)
+		}
+		start = FormatTimestamp(first)
+	}
+	// end is the day after the period
+	var end string
+	if to != "" {
+		last, err := time.Parse(DateLayout, to)
+		if err != nil {
+			return Statement{}, fmt.Errorf("%w: end of the period %q isn't a YYYY-MM-DD date", InvalidInputError, to)
+		}
+		if from != "" && to < from {
+			return Statement{}, fmt.Errorf("%w: period ends before it starts", InvalidInputError)
+		}
+		end = last.AddDate(0, 0, 1).Format(DateLayout)
+	}
+
+	opening, err := s.storage.GetBalanceBefore(ctx, account.Number, start)
+	if err != nil {
+		return Statement{}, fmt.Errorf("error getting opening balance: %w", err)
+	}
+	statement.OpeningBalance = opening
+	statement.ClosingBalance = opening
+
+	query := ListQuery{
+		Filters: []Filter{{Field: "created_at", Operator: FilterGE, Value: start}},
+		OrderBy: []SortField{{Field: "created_at"}},
+	}
+	if end != "" {
+		query.Filters = append(query.Filters, Filter{Field: "created_at", Operator: FilterLT, Value: end})
+	}
+	err = s.eachTransactionsPage(ctx, account.Number, query, func(transactions []TransactionRecord) error {
+		for _, t := range transactions {
+			statement.add(t)
+		}
+		return nil
+	})
+	if err != nil {
+		return Statement{}, err
+	}
+	return statement, nil
+}
@@ -0,0 +1,400 @@
+package server
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// camt053Namespace is the version of ISO 20022 camt.053 the statements are rendered in,
+// it is the one the banks accept most widely
+const camt053Namespace = "urn:iso:std:iso:20022:tech:xsd:camt.053.001.02"
+
+// The elements of camt.053 the statements use, in the order of the schema
+type camt053Document struct {
+	XMLName xml.Name         `xml:"Document"`
+	Xmlns   string           `xml:"xmlns,attr"`
+	Stmt    camt053BkToCstmr `xml:"BkToCstmrStmt"`
+}
+
+type camt053BkToCstmr struct {
+	GrpHdr struct {
+		MsgId   string `xml:"MsgId"`
+		CreDtTm string `xml:"CreDtTm"`
+	} `xml:"GrpHdr"`
+	Stmt camt053Stmt `xml:"Stmt"`
+}
+
+type camt053Stmt struct {
+	Id      string `xml:"Id"`
+	CreDtTm string `xml:"CreDtTm"`
+	FrToDt  struct {
+		FrDtTm string `xml:"FrDtTm"`
+		ToDtTm string `xml:"ToDtTm"`
+	} `xml:"FrToDt"`
+	Acct struct {
+		Id struct {
+			IBAN string `xml:"IBAN,omitempty"`
+			Othr *struct {
+				Id string `xml:"Id"`
+			} `xml:"Othr,omitempty"`
+		} `xml:"Id"`
+		Ccy string `xml:"Ccy"`
+		Nm  string `xml:"Nm,omitempty"`
+	} `xml:"Acct"`
+	Bal       []camt053Bal `xml:"Bal"`
+	TxsSummry struct {
+		TtlNtries    camt053Summary `xml:"TtlNtries"`
+		TtlCdtNtries camt053Summary `xml:"TtlCdtNtries"`
+		TtlDbtNtries camt053Summary `xml:"TtlDbtNtries"`
+	} `xml:"TxsSummry"`
+	Ntry []camt053Ntry `xml:"Ntry"`
+}
+
+type camt053Bal struct {
+	Tp        string        `xml:"Tp>CdOrPrtry>Cd"`
+	Amt       camt053Amount `xml:"Amt"`
+	CdtDbtInd string        `xml:"CdtDbtInd"`
+	Dt        string        `xml:"Dt>Dt"`
+}
+
+type camt053Summary struct {
+	NbOfNtries int    `xml:"NbOfNtries"`
+	Sum        string `xml:"Sum"`
+}
+
+type camt053Amount struct {
+	Ccy   string `xml:"Ccy,attr"`
+	Value string `xml:",chardata"`
+}
+
+type camt053Ntry struct {
+	NtryRef     string        `xml:"NtryRef"`
+	Amt         camt053Amount `xml:"Amt"`
+	CdtDbtInd   string        `xml:"CdtDbtInd"`
+	RvslInd     bool          `xml:"RvslInd,omitempty"`
+	Sts         string        `xml:"Sts"`
+	BookgDt     string        `xml:"BookgDt>Dt"`
+	ValDt       string        `xml:"ValDt>Dt"`
+	AcctSvcrRef string        `xml:"AcctSvcrRef"`
+	BkTxCd      struct {
+		Cd   string `xml:"Cd"`
+		Issr string `xml:"Issr"`
+	} `xml:"BkTxCd>Prtry"`
+	TxDtls struct {
+		Refs *struct {
+			EndToEndId string `xml:"EndToEndId"`
+		} `xml:"Refs,omitempty"`
+		RmtInf *struct {
+			Ustrd string `xml:"Ustrd"`
+		} `xml:"RmtInf,omitempty"`
+	} `xml:"NtryDtls>TxDtls"`
+}
+
+// RenderCamt053 renders the statement as an ISO 20022 camt.053 bank to customer statement, the account is
+// identified by its IBAN or by its number if it has none. The texts are cut to the lengths the schema allows.
+// The statement must pass checkStatementDates
+func RenderCamt053(statement Statement, currency string, createdAt time.Time) ([]byte, error) {
+	if err := checkStatementDates(statement); err != nil {
+		return nil, err
+	}
+	exponent, _ := CurrencyExponent(currency)
+	created := createdAt.UTC().Format("2006-01-02T15:04:05")
+	id := statementId(statement)
+
+	var doc camt053Document
+	doc.Xmlns = camt053Namespace
+	doc.Stmt.GrpHdr.MsgId = id
+	doc.Stmt.GrpHdr.CreDtTm = created
+	st := &doc.Stmt.Stmt
+	st.Id = id
+	st.CreDtTm = created
+	st.FrToDt.FrDtTm = statement.From + "T00:00:00"
+	st.FrToDt.ToDtTm = statement.To + "T23:59:59"
+	if statement.Account.IBAN != "" {
+		st.Acct.Id.IBAN = strings.ReplaceAll(statement.Account.IBAN, " ", "")
+	} else {
+		st.Acct.Id.Othr = &struct {
+			Id string `xml:"Id"`
+		}{Id: truncate(statement.Account.Number, 34)}
+	}
+	st.Acct.Ccy = currency
+	st.Acct.Nm = truncate(statement.Account.Name, 70)
+
+	balance := func(code string, amount int64, date string) camt053Bal {
+		return camt053Bal{
+			Tp:        code,
+			Amt:       camt053Amount{Ccy: currency, Value: formatMinorUnits(abs(amount), exponent, ".")},
+			CdtDbtInd: camt053Indicator(amount >= 0),
+			Dt:        date,
+		}
+	}
+	st.Bal = []camt053Bal{
+		balance("OPBD", statement.OpeningBalance, statement.From),
+		balance("CLBD", statement.ClosingBalance, statement.To),
+	}
+
+	var credits, debits int
+	for _, line := range statement.Lines {
+		t := line.Transaction
+		if t.Type == WithdrawalType {
+			debits++
+		} else {
+			credits++
+		}
+		entry := camt053Ntry{
+			NtryRef:     t.Id,
+			Amt:         camt053Amount{Ccy: currency, Value: formatMinorUnits(t.Amount, exponent, ".")},
+			CdtDbtInd:   camt053Indicator(t.Type != WithdrawalType),
+			RvslInd:     t.ReversedTransactionId != "",
+			Sts:         "BOOK",
+			BookgDt:     bookingDate(t),
+			ValDt:       valueDate(t),
+			AcctSvcrRef: t.Id,
+		}
+		entry.BkTxCd.Cd = t.Type
+		entry.BkTxCd.Issr = "LEDGER"
+		if t.Reference != "" {
+			entry.TxDtls.Refs = &struct {
+				EndToEndId string `xml:"EndToEndId"`
+			}{EndToEndId: truncate(t.Reference, 35)}
+		}
+		if t.Description != "" {
+			entry.TxDtls.RmtInf = &struct {
+				Ustrd string `xml:"Ustrd"`
+			}{Ustrd: truncate(t.Description, 140)}
+		}
+		st.Ntry = append(st.Ntry, entry)
+	}
+	st.TxsSummry.TtlNtries = camt053Summary{
+		NbOfNtries: len(statement.Lines),
+		Sum:        formatMinorUnits(statement.TotalDeposits+statement.TotalWithdrawals, exponent, "."),
+	}
+	st.TxsSummry.TtlCdtNtries = camt053Summary{NbOfNtries: credits, Sum: formatMinorUnits(statement.TotalDeposits, exponent, ".")}
+	st.TxsSummry.TtlDbtNtries = camt053Summary{NbOfNtries: debits, Sum: formatMinorUnits(statement.TotalWithdrawals, exponent, ".")}
+
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("error rendering camt.053: %w", err)
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func camt053Indicator(credit bool) string {
+	if credit {
+		return "CRDT"
+	}
+	return "DBIT"
+}
+
+// RenderMT940 renders the statement as a SWIFT MT940 customer statement message without the SWIFT envelope,
+// the text is limited to the SWIFT character set. The statement must pass checkStatementDates
+func RenderMT940(statement Statement, currency string) ([]byte, error) {
+	if err := checkStatementDates(statement); err != nil {
+		return nil, err
+	}
+	exponent, _ := CurrencyExponent(currency)
+	account := statement.Account.Number
+	if statement.Account.IBAN != "" {
+		account = strings.ReplaceAll(statement.Account.IBAN, " ", "")
+	}
+	balance := func(tag string, amount int64, date string) string {
+		return fmt.Sprintf(":%s:%s%s%s%s", tag, mt940Mark(amount >= 0), mt940Date(date), currency,
+			formatMinorUnits(abs(amount), exponent, ","),
+		)
+	}
+
+	lines := []string{
+		":20:" + statementId(statement),
+		":25:" + truncate(swiftText(account), 35),
+		":28C:" + statementNumber(statement),
+		balance("60F", statement.OpeningBalance, statement.From),
+	}
+	for _, line := range statement.Lines {
+		t := line.Transaction
+		mark := mt940Mark(t.Type != WithdrawalType)
+		if t.ReversedTransactionId != "" {
+			// a reversal of a credit is a debit and the other way round
+			mark = "R" + mt940Mark(t.Type == WithdrawalType)
+		}
+		code := "NMSC"
+		if t.TransferId != "" {
+			code = "NTRF"
+		}
+		reference := truncate(swiftReference(t.Reference), 16)
+		if reference == "" {
+			reference = "NONREF"
+		}
+		booking := bookingDate(t)
+		// the bank reference is the end of the id, it differs the most between the transactions
+		lines = append(lines, fmt.Sprintf(":61:%s%s%s%s%s%s//%s",
+			mt940Date(valueDate(t)), booking[5:7]+booking[8:10], mark,
+			formatMinorUnits(t.Amount, exponent, ","), code, reference, t.Id[max(0, len(t.Id)-16):],
+		))
+		if info := wrapSwiftText(swiftText(t.Description), 65, 6); len(info) > 0 {
+			lines = append(lines, ":86:"+info[0])
+			lines = append(lines, info[1:]...)
+		}
+	}
+	lines = append(lines, balance("62F", statement.ClosingBalance, statement.To), "-")
+	return []byte(strings.Join(lines, "\r\n") + "\r\n"), nil
+}
+
+// checkStatementDates returns InvalidInputError unless the period of the statement has both ends and every line
+// has a booking and a value date, the statement files can't do without them
+func checkStatementDates(statement Statement) error {
+	if statement.From == "" || statement.To == "" {
+		return fmt.Errorf("%w: statement period must have both ends", InvalidInputError)
+	}
+	for _, date := range []string{statement.From, statement.To} {
+		if _, err := time.Parse(DateLayout, date); err != nil {
+			return fmt.Errorf("%w: statement period %q isn't a YYYY-MM-DD date", InvalidInputError, date)
+		}
+	}
+	for _, line := range statement.Lines {
+		t := line.Transaction
+		for _, date := range []string{bookingDate(t), valueDate(t)} {
+			if _, err := time.Parse(DateLayout, date); err != nil {
+				return fmt.Errorf("%w: transaction %s has no booking or value date", InvalidInputError, t.Id)
+			}
+		}
+	}
+	return nil
+}
+
+func mt940Mark(credit bool) string {
+	if credit {
+		return "C"
+	}
+	return "D"
+}
+
+// mt940Date converts a date in DateLayout to YYMMDD, the date must be checked by checkStatementDates
+func mt940Date(date string) string {
+	return date[2:4] + date[5:7] + date[8:10]
+}
+
+// swiftText replaces the characters outside of the SWIFT X character set with spaces, the lines are joined
+func swiftText(text string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		case strings.ContainsRune("/-?:().,'+ ", r):
+			return r
+		}
+		return ' '
+	}, text))
+}
+
+// wrapSwiftText wraps the text in at most `maxLines` lines of `width` characters on the spaces, the words longer
+// than a line are split. The lines after the first one don't start with ":" or "-", which would be read as a new
+// field or the end of the message, such lines start with a space. The text beyond the last line is dropped
+func wrapSwiftText(text string, width int, maxLines int) []string {
+	var lines []string
+	line := ""
+	// startLine begins a line after the last one with the start of the word
+	startLine := func(word string) {
+		line = ""
+		if len(lines) > 0 && (word[0] == ':' || word[0] == '-') {
+			line = " "
+		}
+	}
+	for _, word := range strings.Fields(text) {
+		if line != "" && len(line)+1+len(word) <= width {
+			line += " " + word
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+		startLine(word)
+		for len(line)+len(word) > width {
+			n := width - len(line)
+			lines = append(lines, line+word[:n])
+			word = word[n:]
+			startLine(word)
+		}
+		line += word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+	return lines[:min(len(lines), maxLines)]
+}
+
+// swiftReference is swiftText for the references, which can't contain spaces or "//"
+func swiftReference(reference string) string {
+	reference = strings.ReplaceAll(swiftText(reference), " ", "")
+	return strings.ReplaceAll(reference, "//", "/")
+}
+
+// statementId identifies the statement by the account and the period in 16 characters, the most MT940 takes,
+// with the start of their hash
+func statementId(statement Statement) string {
+	hash := sha256.Sum256([]byte(statement.Account.Number + "/" + statement.From + "/" + statement.To))
+	return hex.EncodeToString(hash[:8])
+}
+
+// statementNumber numbers the statement by the first day of the period as YYDDD, the year and the day of the year,
+// so the statements of the consecutive periods of the account are numbered in order. The statement must pass
+// checkStatementDates
+func statementNumber(statement Statement) string {
+	from, _ := time.Parse(DateLayout, statement.From)
+	return fmt.Sprintf("%s%03d", from.Format("06"), from.YearDay())
+}
+
+// bookingDate is the day the transaction was recorded on
+func bookingDate(t TransactionRecord) string {
+	if len(t.CreatedAt) < len(DateLayout) {
+		return ""
+	}
+	return t.CreatedAt[:len(DateLayout)]
+}
+
+// valueDate is the value date of the transaction, the booking date if it has none
+func valueDate(t TransactionRecord) string {
+	if t.ValueDate != "" {
+		return t.ValueDate
+	}
+	return bookingDate(t)
+}
+
+// formatMinorUnits formats a non-negative amount of minor units in major units with the decimal separator,
+// e.g. 1050 with exponent 2 is 10.50
+func formatMinorUnits(amount int64, exponent int, separator string) string {
+	digits := strconv.FormatInt(amount, 10)
+	if exponent == 0 {
+		if separator == "," {
+			// MT940 amounts always have the decimal comma
+			return digits + ","
+		}
+		return digits
+	}
+	if len(digits) <= exponent {
+		digits = strings.Repeat("0", exponent-len(digits)+1) + digits
+	}
+	return digits[:len(digits)-exponent] + separator + digits[len(digits)-exponent:]
+}
+
+// truncate cuts the text to at most n characters, the limits of the statement files count characters, not bytes
+func truncate(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
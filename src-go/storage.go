@@ -2,30 +2,168 @@ package server
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"github.com/deepmap/oapi-codegen/pkg/securityprovider"
 	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+	"log"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 )
 
+// Storage is a backend that stores accounts and transactions
+type Storage interface {
+	// ListAccounts returns a page of the accounts matching the query and the total amount of them
+	ListAccounts(ctx context.Context, query ListQuery, pageSize int, pageNumber int) ([]AccountRecord, int, error)
+
+	// GetAccount returns the account with the number, NotFoundError is returned if there is no such account
+	GetAccount(ctx context.Context, number string) (AccountRecord, error)
+
+	// GetAccountById returns the account with the id, NotFoundError is returned if there is no such account
+	GetAccountById(ctx context.Context, id string) (AccountRecord, error)
+
+	// GetAccountByIBAN returns the first account with the IBAN, NotFoundError is returned if there is no such account
+	GetAccountByIBAN(ctx context.Context, iban string) (AccountRecord, error)
+
+	// GetAccountByIdempotencyKey returns the account created with the idempotency key,
+	// NotFoundError is returned if there is no such account
+	GetAccountByIdempotencyKey(ctx context.Context, key string) (AccountRecord, error)
+
+	// ListTransactions returns a page of the transactions of the account matching the query and the total amount of them
+	ListTransactions(ctx context.Context, accountNumber string, query ListQuery, pageSize int, pageNumber int) ([]TransactionRecord, int, error)
+
+	// TransactionsCursor returns the cursor after the newest transaction in the order the transactions are written in
+	TransactionsCursor(ctx context.Context) (string, error)
+
+	// ListTransactionsAfter returns a page of the transactions of all the accounts written after the cursor
+	// in the order they were written in, and the cursor after the last transaction of the page. The order doesn't
+	// depend on the time the transactions were recorded at. The Vault storage also returns the transactions
+	// written shortly before the cursor again, the callers tell them apart by their ids
+	ListTransactionsAfter(ctx context.Context, cursor string, pageSize int, pageNumber int) ([]TransactionRecord, string, error)
+
+	// GetTransaction returns the transaction with the id, NotFoundError is returned if there is no such transaction
+	GetTransaction(ctx context.Context, id string) (TransactionRecord, error)
+
+	// GetTransactionByIdempotencyKey returns the transaction created with the idempotency key,
+	// NotFoundError is returned if there is no such transaction
+	GetTransactionByIdempotencyKey(ctx context.Context, key string) (TransactionRecord, error)
+
+	// GetTransferTransactions returns both legs of the transfer
+	GetTransferTransactions(ctx context.Context, transferId string) ([]TransactionRecord, error)
+
+	// GetReversals returns the compensating transactions of any of the transactions with the ids
+	GetReversals(ctx context.Context, transactionIds []string) ([]TransactionRecord, error)
+
+	// GetBalance returns the sum of deposits minus withdrawals of the account
+	GetBalance(ctx context.Context, accountNumber string) (int64, error)
+
+	// GetBalances returns the balances of the accounts by their numbers, the accounts are summed up together
+	// from the same state of the storage, the Vault storage sums up every vaultMaxPerPage accounts together
+	GetBalances(ctx context.Context, accountNumbers []string) (map[string]int64, error)
+
+	// GetBalanceStates returns the balances of the accounts as GetBalances along with the sequences
+	// of their last withdrawals summed up into the balances
+	GetBalanceStates(ctx context.Context, accountNumbers []string) (map[string]BalanceState, error)
+
+	// GetBalanceBefore returns the sum of the transactions of the account recorded before the time in
+	// TimestampLayout, the transactions recorded without a time are older than any and summed up as well
+	GetBalanceBefore(ctx context.Context, accountNumber string, before string) (int64, error)
+
+	// AddAccount stores a new account and returns its id, DuplicateKeyError is returned if the number
+	// or the idempotency key is taken
+	AddAccount(ctx context.Context, account AccountRecord) (string, error)
+
+	// UpdateAccount replaces the account with the same number, NotFoundError is returned if there is no such account
+	UpdateAccount(ctx context.Context, account AccountRecord) error
+
+	// AddTransaction stores a new transaction and returns its id, DuplicateKeyError is returned
+	// if the idempotency key or the sequence of the withdrawal on the account is taken
+	AddTransaction(ctx context.Context, transaction TransactionRecord) (string, error)
+
+	// AddTransactions atomically stores the transactions, either all of them or none, and returns their ids.
+	// DuplicateKeyError is returned as by AddTransaction
+	AddTransactions(ctx context.Context, transactions []TransactionRecord) ([]string, error)
+
+	// InitCollections creates the collections (tables, indexes, etc.) if they don't exist
+	InitCollections(ctx context.Context) error
+}
+
+var _ Storage = (*VaultStorage)(nil)
+
+// BalanceState is the balance of an account and the sequence of the last withdrawal summed up into it,
+// the next withdrawal checked against the balance is written with the sequence after it
+type BalanceState struct {
+	Balance  int64
+	Sequence int64
+}
+
 // VaultStorage is a service that stores the models in Vault
 type VaultStorage struct {
 	client *ClientWithResponses
 	config VaultConfig
+	// stateMu guards the trusted state of the ledger, the newest state verified by the proofs. It is only held
+	// to read or to swap the state, the proofs are fetched and verified without it
+	stateMu      sync.Mutex
+	trustedState LedgerState
+	// ledgerErr is the error the ledger failed the integrity check with, it is read by every call
+	ledgerErr atomic.Pointer[error]
+	// witnessMu guards the newest revision written by the storage
+	witnessMu sync.Mutex
+	witness   witness
+	// keyless are the collections created without idempotency_key, they are only written by InitCollections
+	keyless map[string]bool
+	// unsequenced is set by InitCollections if the transactions collection was created without sequence
+	unsequenced bool
 }
 
 type VaultConfig struct {
 	Host                       string `default:"https://vault.immudb.io/ics/api/v1"`
-	ApiKey                     string `required:"true"`
+	ApiKey                     string
 	LedgerName                 string `default:"default"`
 	AccountsCollectionName     string `default:"accounts"`
 	TransactionsCollectionName string `default:"transactions"`
+	// StatePath is the file the trusted state of the ledger is kept in between restarts, it isn't kept if it's empty.
+	// A relative path is resolved against the working directory of the server when the storage is created
+	StatePath string `default:"ledger-state.json"`
+	// StateCheckInterval is how often the current state of the ledger is checked against the trusted one,
+	// 0 disables the checks
+	StateCheckInterval time.Duration `default:"1m"`
+	// StateCheckTimeout is how long a check of the ledger state may take, 0 means no limit
+	StateCheckTimeout time.Duration `default:"30s"`
 }
 
+// vaultMaxPerPage is the maximum page size Vault accepts in search requests
+const vaultMaxPerPage = 100
+
 var DuplicateKeyError = fmt.Errorf("duplicate key")
 var InvalidInputError = fmt.Errorf("invalid input")
+var NotFoundError = fmt.Errorf("not found")
+
+// OutdatedCollectionError is returned for the writes and the searches that need a field an existing collection
+// was created without, e.g. the documents with idempotency keys. Vault can't add fields to a collection,
+// the rest of the calls work on such collections as before
+var OutdatedCollectionError = fmt.Errorf("collection created by an older version doesn't support it")
+
+// checkPage validates page parameters, pages are numbered from 1 as in Vault
+func checkPage(pageSize int, pageNumber int) error {
+	if pageSize < 1 || pageNumber < 1 {
+		return fmt.Errorf("%w: page size and page number must be positive", InvalidInputError)
+	}
+	return nil
+}
 
 func NewVaultStorage(config VaultConfig) (*VaultStorage, error) {
+	if config.ApiKey == "" {
+		return nil, fmt.Errorf("vault api key is required")
+	}
+
 	apiKeyProvider, err := securityprovider.NewSecurityProviderApiKey("header", "X-API-Key", config.ApiKey)
 	if err != nil {
 		return nil, fmt.Errorf("error creating vault client: %w", err)
@@ -36,28 +174,327 @@ func NewVaultStorage(config VaultConfig) (*VaultStorage, error) {
 		return nil, fmt.Errorf("error creating vault client: %w", err)
 	}
 
-	return &VaultStorage{client, config}, nil
+	var trustedState LedgerState
+	if config.StatePath != "" {
+		if config.StatePath, err = filepath.Abs(config.StatePath); err != nil {
+			return nil, fmt.Errorf("error resolving ledger state path: %w", err)
+		}
+		if trustedState, err = loadLedgerState(config.StatePath); err != nil {
+			return nil, err
+		}
+		log.Printf("keeping the trusted state of the ledger in %s", config.StatePath)
+	}
+
+	return &VaultStorage{client: client, config: config, trustedState: trustedState, keyless: map[string]bool{}}, nil
 }
 
-func (v *VaultStorage) ListAccounts(ctx context.Context, pageSize int, pageNumber int) ([]AccountRecord, int, error) {
+func (v *VaultStorage) ListAccounts(ctx context.Context, query ListQuery, pageSize int, pageNumber int) ([]AccountRecord, int, error) {
+	query, err := query.check(accountFields)
+	if err != nil {
+		return nil, 0, err
+	}
 	return listDocuments[AccountRecord](
-		ctx, v.client, v.config.LedgerName, v.config.AccountsCollectionName, pageSize, pageNumber, nil,
+		ctx, v.client, v.config.LedgerName, v.config.AccountsCollectionName, pageSize, pageNumber,
+		query.vaultQuery(),
+	)
+}
+
+func (v *VaultStorage) GetAccount(ctx context.Context, number string) (AccountRecord, error) {
+	return v.findAccount(ctx, "number", number)
+}
+
+func (v *VaultStorage) GetAccountById(ctx context.Context, id string) (AccountRecord, error) {
+	return v.findAccount(ctx, "_id", id)
+}
+
+func (v *VaultStorage) GetAccountByIBAN(ctx context.Context, iban string) (AccountRecord, error) {
+	return v.findAccount(ctx, "iban", iban)
+}
+
+func (v *VaultStorage) GetAccountByIdempotencyKey(ctx context.Context, key string) (AccountRecord, error) {
+	if _, err := v.idempotencyKey(v.config.AccountsCollectionName, key); err != nil {
+		return AccountRecord{}, err
+	}
+	return v.findAccount(ctx, "idempotency_key", key)
+}
+
+// findAccount returns the first account with the field equal to the value
+func (v *VaultStorage) findAccount(ctx context.Context, field string, value string) (AccountRecord, error) {
+	accounts, err := searchDocuments[AccountRecord](
+		ctx, v.client, v.config.LedgerName, v.config.AccountsCollectionName, 1, 1,
+		fieldQuery(field, value),
 	)
+	if err != nil {
+		return AccountRecord{}, err
+	}
+	if len(accounts) == 0 {
+		return AccountRecord{}, NotFoundError
+	}
+	return accounts[0], nil
 }
 
-func (v *VaultStorage) ListTransactions(ctx context.Context, accountNumber string, pageSize int, pageNumber int) ([]TransactionRecord, int, error) {
+func (v *VaultStorage) ListTransactions(ctx context.Context, accountNumber string, query ListQuery, pageSize int, pageNumber int) ([]TransactionRecord, int, error) {
+	query, err := query.check(transactionFields)
+	if err != nil {
+		return nil, 0, err
+	}
 	return listDocuments[TransactionRecord](
 		ctx, v.client, v.config.LedgerName, v.config.TransactionsCollectionName, pageSize, pageNumber,
-		&Query{
-			Expressions: &[]QueryExpression{
-				{FieldComparisons: &[]FieldComparison{
-					{Field: "account_number", Operator: EQ, Value: accountNumber},
-				}},
-			},
-		},
+		query.vaultQuery(FieldComparison{Field: "account_number", Operator: EQ, Value: accountNumber}),
+	)
+}
+
+// vaultCursorLookback is how far back the transactions are listed again before the cursor. Vault orders the document
+// ids by the second they were created at and the transaction before them, so a document written concurrently
+// with another one may be committed after it with a smaller id
+const vaultCursorLookback = 10 * time.Second
+
+// vaultCursor is the cursor of the Vault storage: the id of the newest transaction seen and the id of the newest
+// transaction when the cursor was created, the transactions up to the latter are never listed again
+type vaultCursor struct {
+	floor string
+	last  string
+}
+
+func (c vaultCursor) String() string {
+	return c.floor + "/" + c.last
+}
+
+func parseVaultCursor(cursor string) (vaultCursor, error) {
+	if cursor == "" {
+		return vaultCursor{}, nil
+	}
+	floor, last, ok := strings.Cut(cursor, "/")
+	if !ok || (floor != "" && !isDocumentId(floor)) || (last != "" && !isDocumentId(last)) {
+		return vaultCursor{}, fmt.Errorf("%w: bad cursor %q", InvalidInputError, cursor)
+	}
+	return vaultCursor{floor: floor, last: last}, nil
+}
+
+// lookbackDocumentId returns the smallest id Vault could give to a document created `d` before the document
+func lookbackDocumentId(id string, d time.Duration) string {
+	b, _ := hex.DecodeString(id)
+	created := binary.BigEndian.Uint32(b[0:4])
+	seconds := uint32(d / time.Second)
+	if created < seconds {
+		created = seconds
+	}
+	var from [16]byte
+	binary.BigEndian.PutUint32(from[0:4], created-seconds)
+	return hex.EncodeToString(from[:])
+}
+
+var newestFirst = &Query{OrderBy: &[]OrderBy{{Field: "_id", Desc: true}}}
+
+func (v *VaultStorage) TransactionsCursor(ctx context.Context) (string, error) {
+	transactions, err := searchDocuments[TransactionRecord](
+		ctx, v.client, v.config.LedgerName, v.config.TransactionsCollectionName, 1, 1, newestFirst,
+	)
+	if err != nil || len(transactions) == 0 {
+		return vaultCursor{}.String(), err
+	}
+	return vaultCursor{floor: transactions[0].Id, last: transactions[0].Id}.String(), nil
+}
+
+// ListTransactionsAfter lists the transactions by their ids, the ones created within vaultCursorLookback
+// before the last transaction seen are listed again
+func (v *VaultStorage) ListTransactionsAfter(ctx context.Context, cursor string, pageSize int, pageNumber int) ([]TransactionRecord, string, error) {
+	c, err := parseVaultCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	from := c.floor
+	if c.last != "" {
+		from = max(from, lookbackDocumentId(c.last, vaultCursorLookback))
+	}
+	query := &Query{OrderBy: &[]OrderBy{{Field: "_id"}}}
+	if from != "" {
+		query.Expressions = &[]QueryExpression{{FieldComparisons: &[]FieldComparison{
+			{Field: "_id", Operator: GT, Value: from},
+		}}}
+	}
+	transactions, err := searchDocuments[TransactionRecord](
+		ctx, v.client, v.config.LedgerName, v.config.TransactionsCollectionName, pageSize, pageNumber, query,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	for _, t := range transactions {
+		c.last = max(c.last, t.Id)
+	}
+	return transactions, c.String(), nil
+}
+
+func (v *VaultStorage) GetTransaction(ctx context.Context, id string) (TransactionRecord, error) {
+	return v.findTransaction(ctx, "_id", id)
+}
+
+func (v *VaultStorage) GetTransactionByIdempotencyKey(ctx context.Context, key string) (TransactionRecord, error) {
+	if _, err := v.idempotencyKey(v.config.TransactionsCollectionName, key); err != nil {
+		return TransactionRecord{}, err
+	}
+	return v.findTransaction(ctx, "idempotency_key", key)
+}
+
+// findTransaction returns the first transaction with the field equal to the value
+func (v *VaultStorage) findTransaction(ctx context.Context, field string, value string) (TransactionRecord, error) {
+	transactions, err := searchDocuments[TransactionRecord](
+		ctx, v.client, v.config.LedgerName, v.config.TransactionsCollectionName, 1, 1,
+		fieldQuery(field, value),
+	)
+	if err != nil {
+		return TransactionRecord{}, err
+	}
+	if len(transactions) == 0 {
+		return TransactionRecord{}, NotFoundError
+	}
+	return transactions[0], nil
+}
+
+func (v *VaultStorage) GetTransferTransactions(ctx context.Context, transferId string) ([]TransactionRecord, error) {
+	return searchDocuments[TransactionRecord](
+		ctx, v.client, v.config.LedgerName, v.config.TransactionsCollectionName, vaultMaxPerPage, 1,
+		fieldQuery("transfer_id", transferId),
 	)
 }
 
+// GetReversals searches for the reversals in chunks, a transaction has at most one reversal,
+// so a single page per chunk is enough
+func (v *VaultStorage) GetReversals(ctx context.Context, transactionIds []string) ([]TransactionRecord, error) {
+	var reversals []TransactionRecord
+	for start := 0; start < len(transactionIds); start += vaultMaxPerPage {
+		end := min(start+vaultMaxPerPage, len(transactionIds))
+		expressions := make([]QueryExpression, 0, end-start)
+		for _, id := range transactionIds[start:end] {
+			expressions = append(expressions, QueryExpression{FieldComparisons: &[]FieldComparison{
+				{Field: "reversed_transaction_id", Operator: EQ, Value: id},
+			}})
+		}
+		transactions, err := searchDocuments[TransactionRecord](
+			ctx, v.client, v.config.LedgerName, v.config.TransactionsCollectionName, vaultMaxPerPage, 1,
+			&Query{Expressions: &expressions},
+		)
+		if err != nil {
+			return nil, err
+		}
+		reversals = append(reversals, transactions...)
+	}
+	return reversals, nil
+}
+
+// GetBalance pages through all transactions of the account in Vault and sums them up
+func (v *VaultStorage) GetBalance(ctx context.Context, accountNumber string) (int64, error) {
+	states, err := v.sumTransactions(ctx, accountNumberQuery(accountNumber))
+	if err != nil {
+		return 0, err
+	}
+	return states[accountNumber].Balance, nil
+}
+
+func (v *VaultStorage) GetBalances(ctx context.Context, accountNumbers []string) (map[string]int64, error) {
+	states, err := v.GetBalanceStates(ctx, accountNumbers)
+	if err != nil {
+		return nil, err
+	}
+	balances := make(map[string]int64, len(states))
+	for number, state := range states {
+		balances[number] = state.Balance
+	}
+	return balances, nil
+}
+
+// GetBalanceStates sums up the accounts in chunks, a single search per chunk
+func (v *VaultStorage) GetBalanceStates(ctx context.Context, accountNumbers []string) (map[string]BalanceState, error) {
+	balances := make(map[string]BalanceState, len(accountNumbers))
+	for start := 0; start < len(accountNumbers); start += vaultMaxPerPage {
+		end := min(start+vaultMaxPerPage, len(accountNumbers))
+		expressions := make([]QueryExpression, 0, end-start)
+		for _, number := range accountNumbers[start:end] {
+			balances[number] = BalanceState{}
+			expressions = append(expressions, QueryExpression{FieldComparisons: &[]FieldComparison{
+				{Field: "account_number", Operator: EQ, Value: number},
+			}})
+		}
+		sums, err := v.sumTransactions(ctx, &Query{Expressions: &expressions})
+		if err != nil {
+			return nil, err
+		}
+		for number, sum := range sums {
+			balances[number] = sum
+		}
+	}
+	return balances, nil
+}
+
+// GetBalanceBefore sums up the transactions before the time and the ones without created_at in a single search.
+// Vault matches a missing field with null, as the documents written before the timestamps have none
+func (v *VaultStorage) GetBalanceBefore(ctx context.Context, accountNumber string, before string) (int64, error) {
+	states, err := v.sumTransactions(ctx, &Query{Expressions: &[]QueryExpression{
+		{FieldComparisons: &[]FieldComparison{
+			{Field: "account_number", Operator: EQ, Value: accountNumber},
+			{Field: "created_at", Operator: LT, Value: before},
+		}},
+		{FieldComparisons: &[]FieldComparison{
+			{Field: "account_number", Operator: EQ, Value: accountNumber},
+			{Field: "created_at", Operator: EQ, Value: nil},
+		}},
+	}})
+	if err != nil {
+		return 0, err
+	}
+	return states[accountNumber].Balance, nil
+}
+
+// sumTransactions sums up the transactions matching the query by account. The pages are read from a search kept
+// open, so they all come from the snapshot of the ledger taken by the first one and the writes made meanwhile
+// neither shift the pages nor get counted
+func (v *VaultStorage) sumTransactions(ctx context.Context, query *Query) (map[string]BalanceState, error) {
+	balances := map[string]BalanceState{}
+	req := DocumentSearchRequest{Page: 1, PerPage: vaultMaxPerPage, KeepOpen: ptr(true), Query: query}
+	for {
+		r, err := v.client.SearchDocumentWithResponse(ctx, v.config.LedgerName, v.config.TransactionsCollectionName, req)
+		if err != nil {
+			return nil, fmt.Errorf("error searching documents: %w", err)
+		}
+		if req.SearchId == nil && r.StatusCode() == 400 {
+			return nil, fmt.Errorf("%w: %s", InvalidInputError, r.Body)
+		}
+		if r.StatusCode() != 200 {
+			return nil, fmt.Errorf("bad response searching for documents: %s %s", r.Status(), r.Body)
+		}
+		for _, d := range r.JSON200.Revisions {
+			t, err := documentToRecord[TransactionRecord](d.Document)
+			if err != nil {
+				return nil, err
+			}
+			state := balances[t.AccountNumber]
+			state.Balance += t.SignedAmount()
+			state.Sequence = max(state.Sequence, t.Sequence)
+			balances[t.AccountNumber] = state
+		}
+		// Vault closes the search after a short page, after a full one the next page may come out empty
+		if len(r.JSON200.Revisions) < vaultMaxPerPage || r.JSON200.SearchId == "" {
+			return balances, nil
+		}
+		req = DocumentSearchRequest{Page: req.Page + 1, PerPage: vaultMaxPerPage, KeepOpen: ptr(true), SearchId: &r.JSON200.SearchId}
+	}
+}
+
+func accountNumberQuery(accountNumber string) *Query {
+	return fieldQuery("account_number", accountNumber)
+}
+
+// fieldQuery matches the documents with the field equal to the value
+func fieldQuery(field string, value string) *Query {
+	return &Query{
+		Expressions: &[]QueryExpression{
+			{FieldComparisons: &[]FieldComparison{
+				{Field: field, Operator: EQ, Value: value},
+			}},
+		},
+	}
+}
+
 // listDocuments is a generic function to list documents from Vault
 func listDocuments[T AccountRecord | TransactionRecord](
 	ctx context.Context,
@@ -68,7 +505,45 @@ func listDocuments[T AccountRecord | TransactionRecord](
 	pageNumber int,
 	query *Query,
 ) ([]T, int, error) {
-	r, err := client.SearchDocumentWithResponse(context.Background(), ledgerName, collectionName,
+	docs, err := searchDocuments[T](ctx, client, ledgerName, collectionName, pageSize, pageNumber, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	count, err := countDocuments(ctx, client, ledgerName, collectionName, query)
+	if err != nil {
+		return nil, 0, err
+	}
+	return docs, count, nil
+}
+
+// countDocuments returns the total amount of documents matching the query
+func countDocuments(ctx context.Context, client *ClientWithResponses, ledgerName string, collectionName string, query *Query) (int, error) {
+	rc, err := client.CountDocumentsWithResponse(ctx, ledgerName, collectionName, DocumentCountRequest{
+		Query: query,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error counting documents: %w", err)
+	}
+	if rc.StatusCode() == 400 {
+		return 0, fmt.Errorf("%w: %s", InvalidInputError, rc.Body)
+	}
+	if rc.StatusCode() != 200 {
+		return 0, fmt.Errorf("bad response counting documents: %s %s", rc.Status(), rc.Body)
+	}
+	return rc.JSON200.Count, nil
+}
+
+// searchDocuments is a generic function to get a page of documents from Vault
+func searchDocuments[T AccountRecord | TransactionRecord](
+	ctx context.Context,
+	client *ClientWithResponses,
+	ledgerName string,
+	collectionName string,
+	pageSize int,
+	pageNumber int,
+	query *Query,
+) ([]T, error) {
+	r, err := client.SearchDocumentWithResponse(ctx, ledgerName, collectionName,
 		DocumentSearchRequest{
 			Page:    pageNumber,
 			PerPage: pageSize,
@@ -76,100 +551,304 @@ func listDocuments[T AccountRecord | TransactionRecord](
 		},
 	)
 	if err != nil {
-		return nil, 0, fmt.Errorf("error searching documents: %w", err)
+		return nil, fmt.Errorf("error searching documents: %w", err)
 	}
 
+	// e.g. the query is on a field the collection was created without
+	if r.StatusCode() == 400 {
+		return nil, fmt.Errorf("%w: %s", InvalidInputError, r.Body)
+	}
 	if r.StatusCode() != 200 {
-		return nil, 0, fmt.Errorf("bad response searching for documents: %s %s", r.Status(), r.Body)
+		return nil, fmt.Errorf("bad response searching for documents: %s %s", r.Status(), r.Body)
 	}
 
 	var docs []T
 	for _, d := range r.JSON200.Revisions {
-		// Add id field from system field _id
-		d.Document["id"] = d.Document["_id"]
-
-		// Unmarshall documents
-		jstr, err := json.Marshal(d.Document)
-		if err != nil {
-			return nil, 0, fmt.Errorf("error marshalling document: %w", err)
-		}
-		var doc T
-		err = json.Unmarshal(jstr, &doc)
+		doc, err := documentToRecord[T](d.Document)
 		if err != nil {
-			return nil, 0, fmt.Errorf("error unmarshalling document: %w", err)
+			return nil, err
 		}
 		docs = append(docs, doc)
 	}
+	return docs, nil
+}
 
-	// Count total amount of documents
-	rc, err := client.CountDocumentsWithResponse(ctx, ledgerName, collectionName, DocumentCountRequest{
-		Query: query,
-	})
+// documentToRecord converts a Vault document to the model
+func documentToRecord[T AccountRecord | TransactionRecord](document Document) (T, error) {
+	var doc T
+	// Add id field from system field _id
+	document["id"] = document["_id"]
+
+	// Unmarshall documents
+	jstr, err := json.Marshal(document)
 	if err != nil {
-		return nil, 0, fmt.Errorf("error counting documents: %w", err)
+		return doc, fmt.Errorf("error marshalling document: %w", err)
 	}
-	if r.StatusCode() != 200 {
-		return nil, 0, fmt.Errorf("bad response counting documents: %s %s", r.Status(), r.Body)
+	err = json.Unmarshal(jstr, &doc)
+	if err != nil {
+		return doc, fmt.Errorf("error unmarshalling document: %w", err)
 	}
-	return docs, rc.JSON200.Count, nil
+	return doc, nil
 }
 
 func (v *VaultStorage) AddAccount(ctx context.Context, account AccountRecord) (string, error) {
+	key, err := v.idempotencyKey(v.config.AccountsCollectionName, account.IdempotencyKey)
+	if err != nil {
+		return "", err
+	}
+	account.IdempotencyKey = key
 	return v.addDocuments(ctx, v.config.AccountsCollectionName, account)
 }
 
+// UpdateAccount replaces the account document found by the number, Vault keeps the previous revisions
+func (v *VaultStorage) UpdateAccount(ctx context.Context, account AccountRecord) error {
+	if err := account.Validate(); err != nil {
+		return err
+	}
+	// the id is the system field _id, it is stored empty as in new documents
+	account.Id = ""
+	jstr, err := json.Marshal(account)
+	if err != nil {
+		return fmt.Errorf("error marshalling document: %w", err)
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(jstr, &doc); err != nil {
+		return fmt.Errorf("error unmarshalling document: %w", err)
+	}
+
+	r, err := v.client.UpdateDocumentWithResponse(ctx, v.config.LedgerName, v.config.AccountsCollectionName,
+		DocumentUpdateRequest{Document: doc, Query: *fieldQuery("number", account.Number)},
+	)
+	if err != nil {
+		return fmt.Errorf("can't update doc in Vault number=%s err=%w", account.Number, err)
+	}
+	if r.StatusCode() == 404 {
+		return NotFoundError
+	}
+	if r.StatusCode() != 200 {
+		return fmt.Errorf("can't update doc in Vault resp=%s %s number=%s", r.Status(), r.Body, account.Number)
+	}
+	v.recordWitness(v.config.AccountsCollectionName, r.JSON200.DocumentId, &r.JSON200.TransactionId)
+	return nil
+}
+
 func (v *VaultStorage) AddTransaction(ctx context.Context, transaction TransactionRecord) (string, error) {
+	key, err := v.idempotencyKey(v.config.TransactionsCollectionName, transaction.IdempotencyKey)
+	if err != nil {
+		return "", err
+	}
+	transaction.IdempotencyKey = key
+	transaction.Sequence = v.vaultSequence(transaction.Sequence)
 	return v.addDocuments(ctx, v.config.TransactionsCollectionName, transaction)
 }
 
+// AddTransactions stores the transactions with a single DocumentCreateMany call, so they land in one Vault transaction
+func (v *VaultStorage) AddTransactions(ctx context.Context, transactions []TransactionRecord) ([]string, error) {
+	records := make([]Validateble, 0, len(transactions))
+	for _, t := range transactions {
+		key, err := v.idempotencyKey(v.config.TransactionsCollectionName, t.IdempotencyKey)
+		if err != nil {
+			return nil, err
+		}
+		t.IdempotencyKey = key
+		t.Sequence = v.vaultSequence(t.Sequence)
+		records = append(records, t)
+	}
+	return v.addManyDocuments(ctx, v.config.TransactionsCollectionName, records)
+}
+
+// vaultSequence returns the sequence to write a transaction with. The unique index on the account number and
+// the sequence takes a single NULL per account as the one on the idempotency keys, so the transactions without
+// a sequence get a random negative one, it stays below the sequences of the withdrawals. A collection created
+// without sequence has no such index, the sequences there only number the withdrawals
+func (v *VaultStorage) vaultSequence(sequence int64) int64 {
+	if sequence != 0 || v.unsequenced {
+		return sequence
+	}
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	// Vault documents are JSON, the numbers are kept within the integers a float64 holds exactly
+	return -1 - int64(binary.BigEndian.Uint64(b[:])>>12)
+}
+
+// idempotencyKey returns the key to write a document of the collection with. The documents written without a key
+// get a random one: Vault indexes a missing field as NULL and a unique index takes a single NULL, so every document
+// needs a key of its own. A collection created without idempotency_key has no unique index to keep the keys apart,
+// its documents are written without them and OutdatedCollectionError is returned for the ones with a key
+func (v *VaultStorage) idempotencyKey(collectionName string, key string) (string, error) {
+	switch {
+	case v.keyless[collectionName] && key != "":
+		return "", fmt.Errorf("%w: collection %s was created without idempotency_key, "+
+			"idempotency keys need a new collection", OutdatedCollectionError, collectionName)
+	case v.keyless[collectionName] || key != "":
+		return key, nil
+	}
+	return "auto-" + newDocumentId(), nil
+}
+
 // addDocuments is a generic function to add documents to Vault
 func (v *VaultStorage) addDocuments(ctx context.Context, collectionName string, record Validateble) (string, error) {
 	if err := record.Validate(); err != nil {
 		return "", err
 	}
 	r, err := v.client.DocumentCreateWithResponse(ctx, v.config.LedgerName, collectionName, record)
+	if err != nil {
+		return "", fmt.Errorf("can't add doc to Vault doc=%s err=%w", record, err)
+	}
 
 	// already exists
-	if r != nil && r.StatusCode() == 409 {
+	if r.StatusCode() == 409 {
 		return "", DuplicateKeyError
 	}
 
-	if err != nil || r.StatusCode() != 200 {
-		return "", fmt.Errorf("can'a add doc to Vault resp=%s %s doc=%s err=%w", r.Status(), r.Body, record, err)
+	if r.StatusCode() != 200 {
+		return "", fmt.Errorf("can't add doc to Vault resp=%s %s doc=%s", r.Status(), r.Body, record)
 	}
+	v.recordWitness(collectionName, r.JSON200.DocumentId, r.JSON200.TransactionId)
 	return r.JSON200.DocumentId, nil
 }
 
+// addManyDocuments adds the documents to Vault in a single transaction
+func (v *VaultStorage) addManyDocuments(ctx context.Context, collectionName string, records []Validateble) ([]string, error) {
+	docs := make([]map[string]interface{}, 0, len(records))
+	for _, record := range records {
+		if err := record.Validate(); err != nil {
+			return nil, err
+		}
+		// Marshall the record to a generic document
+		jstr, err := json.Marshal(record)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling document: %w", err)
+		}
+		var doc map[string]interface{}
+		if err := json.Unmarshal(jstr, &doc); err != nil {
+			return nil, fmt.Errorf("error unmarshalling document: %w", err)
+		}
+		docs = append(docs, doc)
+	}
+
+	r, err := v.client.DocumentCreateManyWithResponse(ctx, v.config.LedgerName, collectionName,
+		DocumentInsertManyRequest{Documents: docs},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't add docs to Vault err=%w", err)
+	}
+
+	// already exists
+	if r.StatusCode() == 409 {
+		return nil, DuplicateKeyError
+	}
+
+	if r.StatusCode() != 200 {
+		return nil, fmt.Errorf("can't add docs to Vault resp=%s %s", r.Status(), r.Body)
+	}
+	if len(r.JSON200.DocumentIds) > 0 {
+		v.recordWitness(collectionName, r.JSON200.DocumentIds[0], r.JSON200.TransactionId)
+	}
+	return r.JSON200.DocumentIds, nil
+}
+
 // InitCollections creates collections in Vault if they don't exist
 func (v *VaultStorage) InitCollections(ctx context.Context) error {
 	var FieldString = FieldType("STRING")
-	r, err := v.client.CollectionCreateWithResponse(ctx, v.config.LedgerName, v.config.AccountsCollectionName,
-		CollectionCreateRequest{
-			Fields: &[]Field{
-				{"number", &FieldString},
-			},
-			Indexes: &[]Index{
-				{[]string{"number"}, true},
-			},
+	var FieldInteger = FieldType("INTEGER")
+	// Vault only searches by the fields of the schema, so all the fields the lists are filtered by are there
+	err := v.initCollection(ctx, v.config.AccountsCollectionName,
+		[]Field{
+			{Name: "number", Type: &FieldString},
+			{Name: "iban", Type: &FieldString},
+			{Name: "name", Type: &FieldString},
+			{Name: "address", Type: &FieldString},
+			{Name: "currency", Type: &FieldString},
+			{Name: "overdraft_limit", Type: &FieldInteger},
+			{Name: "idempotency_key", Type: &FieldString},
+		},
+		[]Index{
+			{Fields: []string{"number"}, IsUnique: true},
+			{Fields: []string{"idempotency_key"}, IsUnique: true},
+			// not unique as IBANs are optional
+			{Fields: []string{"iban"}, IsUnique: false},
 		},
 	)
-	if err != nil || (r.StatusCode() != 200 && r.StatusCode() != 409) { // 409 - already exists
-		return fmt.Errorf("error creating collection %s resp=%s err=%w", v.config.AccountsCollectionName, r.Status(), err)
-	}
-
-	r, err = v.client.CollectionCreateWithResponse(ctx, v.config.LedgerName, v.config.TransactionsCollectionName,
-		CollectionCreateRequest{
-			Fields: &[]Field{
-				{"account_number", &FieldString},
-			},
-			Indexes: &[]Index{
-				{[]string{"account_number"}, false},
-			},
+	if err != nil {
+		return err
+	}
+
+	return v.initCollection(ctx, v.config.TransactionsCollectionName,
+		[]Field{
+			{Name: "account_number", Type: &FieldString},
+			{Name: "transfer_id", Type: &FieldString},
+			{Name: "reversed_transaction_id", Type: &FieldString},
+			{Name: "amount", Type: &FieldInteger},
+			{Name: "type", Type: &FieldString},
+			{Name: "counterparty_account_number", Type: &FieldString},
+			{Name: "currency", Type: &FieldString},
+			{Name: "created_at", Type: &FieldString},
+			{Name: "value_date", Type: &FieldString},
+			{Name: "reference", Type: &FieldString},
+			{Name: "idempotency_key", Type: &FieldString},
+			{Name: "sequence", Type: &FieldInteger},
+		},
+		[]Index{
+			{Fields: []string{"account_number"}, IsUnique: false},
+			{Fields: []string{"transfer_id"}, IsUnique: false},
+			// not unique as most transactions have it empty, the reversals have unique idempotency keys instead
+			{Fields: []string{"reversed_transaction_id"}, IsUnique: false},
+			// the date ranges of the statements
+			{Fields: []string{"created_at"}, IsUnique: false},
+			{Fields: []string{"value_date"}, IsUnique: false},
+			{Fields: []string{"reference"}, IsUnique: false},
+			{Fields: []string{"idempotency_key"}, IsUnique: true},
+			// the withdrawals checked against the same balance conflict, see TransactionRecord.Sequence
+			{Fields: []string{"account_number", "sequence"}, IsUnique: true},
 		},
 	)
+}
 
-	if err != nil || (r.StatusCode() != 200 && r.StatusCode() != 409) { // 409 - already exists
-		return fmt.Errorf("error creating collection %s resp=%s err=%w", v.config.TransactionsCollectionName, r.Status(), err)
+// initCollection creates the collection, the indexes added since an existing collection was created are created
+// separately. Vault can't add fields to an existing collection, so the indexes on the fields the collection
+// was created without are skipped with a warning, searches by such fields fail. A collection created without
+// idempotency_key is written without the keys, see idempotencyKey, and one created without sequence
+// without the sequence fillers, see vaultSequence
+func (v *VaultStorage) initCollection(ctx context.Context, name string, fields []Field, indexes []Index) error {
+	r, err := v.client.CollectionCreateWithResponse(ctx, v.config.LedgerName, name,
+		CollectionCreateRequest{Fields: &fields, Indexes: &indexes},
+	)
+	if err != nil {
+		return fmt.Errorf("error creating collection %s err=%w", name, err)
+	}
+	if r.StatusCode() == 200 {
+		return nil
+	}
+	if r.StatusCode() != 409 { // 409 - already exists
+		return fmt.Errorf("error creating collection %s resp=%s", name, r.Status())
+	}
+
+	for _, index := range indexes {
+		ri, err := v.client.CreateIndexWithResponse(ctx, v.config.LedgerName, name,
+			IndexCreateRequest{Fields: index.Fields, IsUnique: index.IsUnique},
+		)
+		if err != nil {
+			return fmt.Errorf("error creating index %v of collection %s err=%w", index.Fields, name, err)
+		}
+		switch ri.StatusCode() {
+		case 200, 409: // 409 - already exists
+		case 400:
+			if slices.Contains(index.Fields, "idempotency_key") {
+				v.keyless[name] = true
+				log.Printf("collection %s was created without idempotency_key, the requests with idempotency keys are rejected", name)
+				continue
+			}
+			if slices.Contains(index.Fields, "sequence") {
+				v.unsequenced = true
+				log.Printf("collection %s was created without sequence, the withdrawals are only checked against "+
+					"the concurrent ones of this instance", name)
+				continue
+			}
+			log.Printf("can't index %v of the existing collection %s: %s %s", index.Fields, name, ri.Status(), ri.Body)
+		default:
+			return fmt.Errorf("error creating index %v of collection %s resp=%s", index.Fields, name, ri.Status())
+		}
 	}
 	return nil
 }
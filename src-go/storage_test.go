@@ -0,0 +1,615 @@
+package server
+
+import (
+	"context"
+	"errors"
+	pb "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/proto"
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+	"github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultfake"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"slices"
+	"sort"
+	"testing"
+	"time"
+)
+
+// testStorage is a backend the conformance tests run against
+type testStorage struct {
+	name string
+	open func(t *testing.T) Storage
+}
+
+var testStorages = []testStorage{
+	{name: "memory", open: func(t *testing.T) Storage { return NewMemoryStorage() }},
+	{name: "sqlite", open: func(t *testing.T) Storage {
+		storage, err := NewSqliteStorage(SqliteConfig{Path: filepath.Join(t.TempDir(), "ledger.db")})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return storage
+	}},
+	{name: "vault", open: func(t *testing.T) Storage {
+		storage, _ := newFakeVaultStorage(t)
+		return storage
+	}},
+}
+
+// newFakeVaultStorage returns the Vault storage backed by a fresh fake Vault
+func newFakeVaultStorage(t *testing.T) (*VaultStorage, *vaultfake.Server) {
+	t.Helper()
+	fake := vaultfake.NewServer()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	storage, err := NewVaultStorage(VaultConfig{
+		Host: server.URL, ApiKey: "key", LedgerName: "default",
+		AccountsCollectionName: "accounts", TransactionsCollectionName: "transactions",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return storage, fake
+}
+
+// openTestStorage opens the storage and creates its collections
+func openTestStorage(t *testing.T, backend testStorage) Storage {
+	t.Helper()
+	storage := backend.open(t)
+	if err := storage.InitCollections(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return storage
+}
+
+// testNow is the time the test transactions are recorded at
+var testNow = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+
+func testAccount(number string) AccountRecord {
+	return AccountRecord{Number: number, Name: "Account " + number, IBAN: "DE" + number, Currency: "EUR"}
+}
+
+func testTransaction(accountNumber string, transactionType string, amount int64) TransactionRecord {
+	return TransactionRecord{
+		AccountNumber: accountNumber, Type: transactionType, Amount: amount, Currency: "EUR",
+		CreatedAt: FormatTimestamp(testNow),
+	}
+}
+
+func TestStorageAccounts(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			storage := openTestStorage(t, backend)
+
+			account := testAccount("1001")
+			account.IdempotencyKey = "create-1001"
+			id, err := storage.AddAccount(ctx, account)
+			if err != nil {
+				t.Fatal(err)
+			}
+			account.Id = id
+			if _, err := storage.AddAccount(ctx, testAccount("1002")); err != nil {
+				t.Fatal(err)
+			}
+
+			for name, get := range map[string]func() (AccountRecord, error){
+				"by number":          func() (AccountRecord, error) { return storage.GetAccount(ctx, "1001") },
+				"by id":              func() (AccountRecord, error) { return storage.GetAccountById(ctx, id) },
+				"by IBAN":            func() (AccountRecord, error) { return storage.GetAccountByIBAN(ctx, "DE1001") },
+				"by idempotency key": func() (AccountRecord, error) { return storage.GetAccountByIdempotencyKey(ctx, "create-1001") },
+			} {
+				got, err := get()
+				if err != nil {
+					t.Fatalf("%s: %v", name, err)
+				}
+				if got != account {
+					t.Fatalf("%s: got %+v, expected %+v", name, got, account)
+				}
+			}
+			if _, err := storage.GetAccount(ctx, "1003"); !errors.Is(err, NotFoundError) {
+				t.Fatalf("missing account: expected NotFoundError, got %v", err)
+			}
+			if _, err := storage.GetAccountByIdempotencyKey(ctx, "create-1003"); !errors.Is(err, NotFoundError) {
+				t.Fatalf("missing idempotency key: expected NotFoundError, got %v", err)
+			}
+
+			duplicate := testAccount("1001")
+			if _, err := storage.AddAccount(ctx, duplicate); !errors.Is(err, DuplicateKeyError) {
+				t.Fatalf("duplicate number: expected DuplicateKeyError, got %v", err)
+			}
+			duplicate = testAccount("1003")
+			duplicate.IdempotencyKey = "create-1001"
+			if _, err := storage.AddAccount(ctx, duplicate); !errors.Is(err, DuplicateKeyError) {
+				t.Fatalf("duplicate idempotency key: expected DuplicateKeyError, got %v", err)
+			}
+			if _, err := storage.AddAccount(ctx, AccountRecord{Number: "1003", Currency: "EUR"}); !errors.Is(err, InvalidInputError) {
+				t.Fatalf("account without a name: expected InvalidInputError, got %v", err)
+			}
+
+			account.Name = "Renamed"
+			account.OverdraftLimit = 500
+			if err := storage.UpdateAccount(ctx, account); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := storage.GetAccount(ctx, "1001"); err != nil || got != account {
+				t.Fatalf("updated account: got %+v %v, expected %+v", got, err, account)
+			}
+			if err := storage.UpdateAccount(ctx, testAccount("1003")); !errors.Is(err, NotFoundError) {
+				t.Fatalf("update of a missing account: expected NotFoundError, got %v", err)
+			}
+
+			accounts, total, err := storage.ListAccounts(ctx, ListQuery{OrderBy: []SortField{{Field: "number", Desc: true}}}, 1, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != 2 || len(accounts) != 1 || accounts[0].Number != "1001" {
+				t.Fatalf("second page of the accounts by number descending: got %+v of %d", accounts, total)
+			}
+		})
+	}
+}
+
+func TestStorageTransactions(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			storage := openTestStorage(t, backend)
+			for _, number := range []string{"1001", "1002"} {
+				if _, err := storage.AddAccount(ctx, testAccount(number)); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			deposit := testTransaction("1001", DepositType, 1000)
+			deposit.IdempotencyKey = "deposit-1"
+			deposit.Metadata = map[string]string{"order": "17"}
+			depositId, err := storage.AddTransaction(ctx, deposit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			deposit.Id = depositId
+			if got, err := storage.GetTransaction(ctx, depositId); err != nil || !got.SameRequest(deposit) || got.Id != depositId {
+				t.Fatalf("got %+v %v, expected %+v", got, err, deposit)
+			}
+			if got, err := storage.GetTransactionByIdempotencyKey(ctx, "deposit-1"); err != nil || got.Id != depositId {
+				t.Fatalf("by idempotency key: got %+v %v", got, err)
+			}
+			if _, err := storage.AddTransaction(ctx, deposit); !errors.Is(err, DuplicateKeyError) {
+				t.Fatalf("duplicate idempotency key: expected DuplicateKeyError, got %v", err)
+			}
+			if _, err := storage.GetTransaction(ctx, "000000000000000000000000000000ff"); !errors.Is(err, NotFoundError) {
+				t.Fatalf("missing transaction: expected NotFoundError, got %v", err)
+			}
+
+			withdrawal := testTransaction("1001", WithdrawalType, 300)
+			withdrawalId, err := storage.AddTransaction(ctx, withdrawal)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// a transfer is written at once, a bad leg fails the whole transfer
+			out := testTransaction("1001", WithdrawalType, 200)
+			in := testTransaction("1002", DepositType, 200)
+			out.TransferId, out.CounterpartyAccountNumber = "transfer-1", "1002"
+			in.TransferId, in.CounterpartyAccountNumber = "transfer-1", "1001"
+			bad := testTransaction("1002", DepositType, 0)
+			if _, err := storage.AddTransactions(ctx, []TransactionRecord{out, bad}); !errors.Is(err, InvalidInputError) {
+				t.Fatalf("batch with an invalid transaction: expected InvalidInputError, got %v", err)
+			}
+			ids, err := storage.AddTransactions(ctx, []TransactionRecord{out, in})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ids) != 2 {
+				t.Fatalf("expected 2 ids, got %v", ids)
+			}
+			legs, err := storage.GetTransferTransactions(ctx, "transfer-1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			legIds := []string{legs[0].Id, legs[1].Id}
+			sort.Strings(legIds)
+			sort.Strings(ids)
+			if len(legs) != 2 || legIds[0] != ids[0] || legIds[1] != ids[1] {
+				t.Fatalf("transfer legs: got %+v, expected the ids %v", legs, ids)
+			}
+
+			reversal := testTransaction("1001", DepositType, 300)
+			reversal.ReversedTransactionId = withdrawalId
+			reversalId, err := storage.AddTransaction(ctx, reversal)
+			if err != nil {
+				t.Fatal(err)
+			}
+			reversals, err := storage.GetReversals(ctx, []string{depositId, withdrawalId})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(reversals) != 1 || reversals[0].Id != reversalId {
+				t.Fatalf("reversals: got %+v, expected %s", reversals, reversalId)
+			}
+
+			expectedBalances := map[string]int64{"1001": 1000 - 300 - 200 + 300, "1002": 200, "1003": 0}
+			for number, expected := range expectedBalances {
+				balance, err := storage.GetBalance(ctx, number)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if balance != expected {
+					t.Fatalf("balance of %s: got %d, expected %d", number, balance, expected)
+				}
+			}
+			balances, err := storage.GetBalances(ctx, []string{"1001", "1002", "1003"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(balances, expectedBalances) {
+				t.Fatalf("balances: got %v, expected %v", balances, expectedBalances)
+			}
+
+			transactions, total, err := storage.ListTransactions(ctx, "1001",
+				ListQuery{Filters: []Filter{{Field: "type", Operator: FilterEQ, Value: DepositType}}}, 10, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != 2 || len(transactions) != 2 {
+				t.Fatalf("deposits of 1001: got %+v of %d", transactions, total)
+			}
+		})
+	}
+}
+
+func TestStorageBalances(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			storage := openTestStorage(t, backend)
+			// a full page of transactions of one account and a page and a half of all of them
+			var transactions []TransactionRecord
+			for i := 0; i < vaultMaxPerPage; i++ {
+				transactions = append(transactions, testTransaction("1001", DepositType, 2))
+				if i%2 == 0 {
+					transactions = append(transactions, testTransaction("1002", WithdrawalType, 1))
+				}
+			}
+			if _, err := storage.AddTransactions(ctx, transactions); err != nil {
+				t.Fatal(err)
+			}
+
+			expected := map[string]int64{"1001": 2 * vaultMaxPerPage}
+			balances, err := storage.GetBalances(ctx, []string{"1001"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(balances, expected) {
+				t.Fatalf("balances: got %v, expected %v", balances, expected)
+			}
+			expected = map[string]int64{"1001": 2 * vaultMaxPerPage, "1002": -vaultMaxPerPage / 2, "1003": 0}
+			if balances, err = storage.GetBalances(ctx, []string{"1001", "1002", "1003"}); err != nil {
+				t.Fatal(err)
+			}
+			if !maps.Equal(balances, expected) {
+				t.Fatalf("balances: got %v, expected %v", balances, expected)
+			}
+			if balances, err = storage.GetBalances(ctx, nil); err != nil || len(balances) != 0 {
+				t.Fatalf("balances of no accounts: got %v, %v", balances, err)
+			}
+
+			// the transactions without a time are older than any
+			undated := testTransaction("1001", DepositType, 1)
+			undated.CreatedAt = ""
+			if _, err := storage.AddTransaction(ctx, undated); err != nil {
+				t.Fatal(err)
+			}
+			for before, expected := range map[time.Time]int64{testNow: 1, testNow.Add(time.Microsecond): 2*vaultMaxPerPage + 1} {
+				balance, err := storage.GetBalanceBefore(ctx, "1001", FormatTimestamp(before))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if balance != expected {
+					t.Fatalf("balance before %s: got %d, expected %d", before, balance, expected)
+				}
+			}
+		})
+	}
+}
+
+func TestStorageSequences(t *testing.T) {
+	ctx := context.Background()
+	withdrawal := func(accountNumber string, sequence int64) TransactionRecord {
+		transaction := testTransaction(accountNumber, WithdrawalType, 1)
+		transaction.Sequence = sequence
+		return transaction
+	}
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			storage := openTestStorage(t, backend)
+			// the transactions without a sequence don't conflict, the sequences are taken per account
+			if _, err := storage.AddTransactions(ctx, []TransactionRecord{
+				testTransaction("1001", DepositType, 5), testTransaction("1001", DepositType, 5),
+				testTransaction("1001", WithdrawalType, 1), withdrawal("1001", 1), withdrawal("1002", 1),
+			}); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := storage.AddTransaction(ctx, withdrawal("1001", 1)); !errors.Is(err, DuplicateKeyError) {
+				t.Fatalf("expected %v adding a taken sequence, got %v", DuplicateKeyError, err)
+			}
+			// none of the transactions is written if a sequence is taken
+			_, err := storage.AddTransactions(ctx, []TransactionRecord{withdrawal("1001", 2), withdrawal("1002", 1)})
+			if !errors.Is(err, DuplicateKeyError) {
+				t.Fatalf("expected %v adding a taken sequence, got %v", DuplicateKeyError, err)
+			}
+			if _, err := storage.AddTransaction(ctx, withdrawal("1001", 2)); err != nil {
+				t.Fatal(err)
+			}
+
+			states, err := storage.GetBalanceStates(ctx, []string{"1001", "1002", "1003"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := map[string]BalanceState{"1001": {Balance: 7, Sequence: 2}, "1002": {Balance: -1, Sequence: 1}, "1003": {}}
+			if !maps.Equal(states, expected) {
+				t.Fatalf("balance states: got %v, expected %v", states, expected)
+			}
+		})
+	}
+}
+
+func TestStorageTransactionsCursor(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			storage := openTestStorage(t, backend)
+			if _, err := storage.AddAccount(ctx, testAccount("1001")); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := storage.AddTransaction(ctx, testTransaction("1001", DepositType, 1)); err != nil {
+				t.Fatal(err)
+			}
+			cursor, err := storage.TransactionsCursor(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the transactions are listed in the order they were written in, whatever their time
+			var written []string
+			for _, createdAt := range []string{"2024-05-01T10:00:00.000000Z", "2001-01-01T00:00:00.000000Z", ""} {
+				transaction := testTransaction("1001", DepositType, 2)
+				transaction.CreatedAt = createdAt
+				id, err := storage.AddTransaction(ctx, transaction)
+				if err != nil {
+					t.Fatal(err)
+				}
+				written = append(written, id)
+			}
+			var listed []string
+			next := cursor
+			for page := 1; ; page++ {
+				transactions, pageCursor, err := storage.ListTransactionsAfter(ctx, cursor, 2, page)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for _, transaction := range transactions {
+					listed = append(listed, transaction.Id)
+				}
+				if len(transactions) > 0 {
+					next = pageCursor
+				}
+				if len(transactions) < 2 {
+					break
+				}
+			}
+			if !slices.Equal(listed, written) {
+				t.Fatalf("listed %v after the cursor, expected %v", listed, written)
+			}
+
+			// the cursor after the listed transactions lists only the new ones, except the ones Vault may list again
+			id, err := storage.AddTransaction(ctx, testTransaction("1001", DepositType, 3))
+			if err != nil {
+				t.Fatal(err)
+			}
+			transactions, _, err := storage.ListTransactionsAfter(ctx, next, 10, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(transactions) == 0 || transactions[len(transactions)-1].Id != id {
+				t.Fatalf("listed %+v after the listed transactions, expected %s last", transactions, id)
+			}
+			for _, transaction := range transactions[:len(transactions)-1] {
+				if !slices.Contains(written, transaction.Id) {
+					t.Fatalf("listed %s after the listed transactions", transaction.Id)
+				}
+			}
+		})
+	}
+}
+
+func TestVaultStorageOutdatedCollection(t *testing.T) {
+	ctx := context.Background()
+	str := FieldType("STRING")
+	tests := []struct {
+		name   string
+		fields []string
+		// keyless tells that the collection has no idempotency keys
+		keyless bool
+	}{
+		// the fields added later are only needed by the searches on them
+		{name: "without reference", fields: []string{"account_number", "idempotency_key"}},
+		{name: "without idempotency key", fields: []string{"account_number", "reference"}, keyless: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, _ := newFakeVaultStorage(t)
+			var fields []Field
+			for _, name := range tt.fields {
+				fields = append(fields, Field{Name: name, Type: &str})
+			}
+			r, err := storage.client.CollectionCreateWithResponse(ctx, "default", "transactions",
+				CollectionCreateRequest{Fields: &fields},
+			)
+			if err != nil || r.StatusCode() != 200 {
+				t.Fatalf("can't create the collection: %v %s", err, r.Body)
+			}
+			// the service starts on the collections of older versions
+			if err := storage.InitCollections(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := storage.AddAccount(ctx, testAccount("1001")); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := storage.AddTransaction(ctx, testTransaction("1001", DepositType, 1)); err != nil {
+				t.Fatal(err)
+			}
+
+			// only the requests with idempotency keys are rejected
+			var expected error
+			if tt.keyless {
+				expected = OutdatedCollectionError
+			}
+			keyed := testTransaction("1001", DepositType, 1)
+			keyed.IdempotencyKey = "key"
+			if _, err := storage.AddTransaction(ctx, keyed); !errors.Is(err, expected) {
+				t.Fatalf("expected %v adding a transaction with a key, got %v", expected, err)
+			}
+			if _, err := storage.AddTransactions(ctx, []TransactionRecord{keyed}); tt.keyless && !errors.Is(err, expected) {
+				t.Fatalf("expected %v adding transactions with a key, got %v", expected, err)
+			}
+			if _, err := storage.GetTransactionByIdempotencyKey(ctx, "other"); !errors.Is(err, expected) && !errors.Is(err, NotFoundError) {
+				t.Fatalf("expected %v getting a transaction by its key, got %v", expected, err)
+			}
+			if _, err := storage.GetAccountByIdempotencyKey(ctx, "other"); !errors.Is(err, NotFoundError) {
+				t.Fatalf("expected %v getting an account by its key, got %v", NotFoundError, err)
+			}
+
+			service, err := NewAccountService(storage, AccountServiceConfig{DefaultCurrency: "EUR", MaxBatchSize: 1000})
+			if err != nil {
+				t.Fatal(err)
+			}
+			withKey := metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyHeader, "request"))
+			_, err = service.CreateTransaction(withKey, &pb.Transaction{AccountNumber: "1001", Amount: 1})
+			if tt.keyless {
+				expectCode(t, err, codes.FailedPrecondition)
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := service.CreateTransaction(ctx, &pb.Transaction{AccountNumber: "1001", Amount: 1}); err != nil {
+				t.Fatal(err)
+			}
+			// the withdrawals are written without the index on their sequences
+			withdraw := &pb.Transaction{AccountNumber: "1001", Amount: 1, Type: pb.TransactionType_WITHDRAWAL}
+			for i := 0; i < 2; i++ {
+				if _, err := service.CreateTransaction(ctx, withdraw); err != nil {
+					t.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestVaultStorageErrors(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		operation string
+		status    int
+		call      func(storage *VaultStorage) error
+		// expected is the error the call fails with, nil for an error that isn't any of the storage errors
+		expected error
+	}{
+		{
+			name: "account conflict", operation: "DocumentCreate", status: http.StatusConflict,
+			call:     func(s *VaultStorage) error { _, err := s.AddAccount(ctx, testAccount("1002")); return err },
+			expected: DuplicateKeyError,
+		},
+		{
+			name: "account not created", operation: "DocumentCreate", status: http.StatusInternalServerError,
+			call: func(s *VaultStorage) error { _, err := s.AddAccount(ctx, testAccount("1002")); return err },
+		},
+		{
+			name: "transactions conflict", operation: "DocumentCreateMany", status: http.StatusConflict,
+			call: func(s *VaultStorage) error {
+				_, err := s.AddTransactions(ctx, []TransactionRecord{testTransaction("1001", DepositType, 1)})
+				return err
+			},
+			expected: DuplicateKeyError,
+		},
+		{
+			name: "transactions not created", operation: "DocumentCreateMany", status: http.StatusServiceUnavailable,
+			call: func(s *VaultStorage) error {
+				_, err := s.AddTransactions(ctx, []TransactionRecord{testTransaction("1001", DepositType, 1)})
+				return err
+			},
+		},
+		{
+			name: "bad search", operation: "SearchDocument", status: http.StatusBadRequest,
+			call:     func(s *VaultStorage) error { _, _, err := s.ListAccounts(ctx, ListQuery{}, 10, 1); return err },
+			expected: InvalidInputError,
+		},
+		{
+			name: "search failed", operation: "SearchDocument", status: http.StatusInternalServerError,
+			call: func(s *VaultStorage) error { _, _, err := s.ListAccounts(ctx, ListQuery{}, 10, 1); return err },
+		},
+		{
+			name: "bad count", operation: "CountDocuments", status: http.StatusBadRequest,
+			call: func(s *VaultStorage) error {
+				_, _, err := s.ListTransactions(ctx, "1001", ListQuery{}, 10, 1)
+				return err
+			},
+			expected: InvalidInputError,
+		},
+		{
+			name: "count failed", operation: "CountDocuments", status: http.StatusBadGateway,
+			call: func(s *VaultStorage) error {
+				_, _, err := s.ListTransactions(ctx, "1001", ListQuery{}, 10, 1)
+				return err
+			},
+		},
+		{
+			name: "balance search failed", operation: "SearchDocument", status: http.StatusInternalServerError,
+			call: func(s *VaultStorage) error { _, err := s.GetBalance(ctx, "1001"); return err },
+		},
+		{
+			name: "balances search failed", operation: "SearchDocument", status: http.StatusInternalServerError,
+			call: func(s *VaultStorage) error { _, err := s.GetBalances(ctx, []string{"1001", "1002"}); return err },
+		},
+		{
+			name: "account to update not found", operation: "UpdateDocument", status: http.StatusNotFound,
+			call:     func(s *VaultStorage) error { return s.UpdateAccount(ctx, testAccount("1001")) },
+			expected: NotFoundError,
+		},
+		{
+			name: "update failed", operation: "UpdateDocument", status: http.StatusInternalServerError,
+			call: func(s *VaultStorage) error { return s.UpdateAccount(ctx, testAccount("1001")) },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, fake := newFakeVaultStorage(t)
+			if err := storage.InitCollections(ctx); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := storage.AddAccount(ctx, testAccount("1001")); err != nil {
+				t.Fatal(err)
+			}
+			fake.FailNext(tt.operation, tt.status)
+			err := tt.call(storage)
+			if tt.expected != nil {
+				if !errors.Is(err, tt.expected) {
+					t.Fatalf("expected %v, got %v", tt.expected, err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+			for _, storageErr := range []error{DuplicateKeyError, InvalidInputError, NotFoundError} {
+				if errors.Is(err, storageErr) {
+					t.Fatalf("HTTP %d is reported as %v: %v", tt.status, storageErr, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package vaultfake
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+)
+
+// Column ids of the SQL table of a collection, the fields of the collection follow the blob of the document
+const (
+	idColumn  = uint32(1)
+	docColumn = uint32(2)
+)
+
+// encodeDocument encodes the document as the value of the SQL row of the collection, the way Vault stores it:
+// the number of values followed by the column id, length and value of every column that isn't null.
+// The id is stored as raw bytes and the whole document as a protobuf Struct.
+func (c *collection) encodeDocument(id string, doc Document) ([]byte, error) {
+	rawId, err := hex.DecodeString(id)
+	if err != nil {
+		return nil, err
+	}
+	s, err := structpb.NewStruct(doc)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := proto.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var values []byte
+	count := 2
+	values = appendColumn(values, idColumn, rawId)
+	values = appendColumn(values, docColumn, blob)
+	for i, f := range c.fields {
+		v, ok := fieldValue(s, f.Name)
+		if !ok {
+			continue
+		}
+		encoded, ok := encodeField(v, f.Type)
+		if !ok {
+			continue
+		}
+		values = appendColumn(values, docColumn+1+uint32(i), encoded)
+		count++
+	}
+	return append(binary.BigEndian.AppendUint32(nil, uint32(count)), values...), nil
+}
+
+func appendColumn(b []byte, columnId uint32, value []byte) []byte {
+	b = binary.BigEndian.AppendUint32(b, columnId)
+	b = binary.BigEndian.AppendUint32(b, uint32(len(value)))
+	return append(b, value...)
+}
+
+// fieldValue finds the value of the field, nested fields are separated with dots. Null values aren't stored.
+func fieldValue(s *structpb.Struct, name string) (*structpb.Value, bool) {
+	path := strings.Split(name, ".")
+	v, ok := s.GetFields()[path[0]]
+	for _, p := range path[1:] {
+		if !ok {
+			break
+		}
+		v, ok = v.GetStructValue().GetFields()[p]
+	}
+	if !ok {
+		return nil, false
+	}
+	if _, isNull := v.GetKind().(*structpb.Value_NullValue); isNull {
+		return nil, false
+	}
+	return v, true
+}
+
+// encodeField encodes the value of a field of the collection, values of a wrong type aren't stored
+func encodeField(v *structpb.Value, t *FieldType) ([]byte, bool) {
+	fieldType := STRING
+	if t != nil {
+		fieldType = *t
+	}
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		if fieldType == STRING {
+			return []byte(k.StringValue), true
+		}
+	case *structpb.Value_NumberValue:
+		if fieldType == INTEGER {
+			return binary.BigEndian.AppendUint64(nil, uint64(int64(k.NumberValue))), true
+		}
+		if fieldType == DOUBLE {
+			return binary.BigEndian.AppendUint64(nil, math.Float64bits(k.NumberValue)), true
+		}
+	case *structpb.Value_BoolValue:
+		if fieldType == BOOLEAN {
+			if k.BoolValue {
+				return []byte{1}, true
+			}
+			return []byte{0}, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,84 @@
+package vaultfake
+
+import (
+	"crypto/sha256"
+	"math/bits"
+)
+
+// linkingTree is the binary linking tree of a ledger, its leaves are the hashes of the transactions.
+// It has the shape of the trees of RFC 6962 and produces the proofs in the same format as immudb.
+type linkingTree struct {
+	leaves [][sha256.Size]byte
+}
+
+func (t *linkingTree) append(alh [sha256.Size]byte) {
+	b := append([]byte{0}, alh[:]...)
+	t.leaves = append(t.leaves, sha256.Sum256(b))
+}
+
+// root returns the root of the tree of the first `n` leaves, the root of an empty tree is all zeros
+func (t *linkingTree) root(n uint64) [sha256.Size]byte {
+	if n == 0 {
+		return [sha256.Size]byte{}
+	}
+	return t.subtreeRoot(0, n)
+}
+
+func (t *linkingTree) subtreeRoot(from, to uint64) [sha256.Size]byte {
+	if to-from == 1 {
+		return t.leaves[from]
+	}
+	k := from + split(to-from)
+	return nodeHash(t.subtreeRoot(from, k), t.subtreeRoot(k, to))
+}
+
+// inclusionProof proves that the leaf `i` is in the tree of the first `n` leaves, leaves are numbered from 1
+func (t *linkingTree) inclusionProof(i, n uint64) [][]byte {
+	return t.path(i-1, 0, n)
+}
+
+func (t *linkingTree) path(i, from, to uint64) [][]byte {
+	if to-from == 1 {
+		return nil
+	}
+	k := from + split(to-from)
+	if i < k {
+		r := t.subtreeRoot(k, to)
+		return append(t.path(i, from, k), r[:])
+	}
+	r := t.subtreeRoot(from, k)
+	return append(t.path(i, k, to), r[:])
+}
+
+// consistencyProof proves that the tree of the first `m` leaves is a prefix of the tree of the first `n` leaves.
+// Unlike RFC 6962 the proof always starts with the root of the subtree it is built from.
+func (t *linkingTree) consistencyProof(m, n uint64) [][]byte {
+	if m == n {
+		return nil
+	}
+	return t.subproof(m, 0, n)
+}
+
+func (t *linkingTree) subproof(m, from, to uint64) [][]byte {
+	if m == to-from {
+		r := t.subtreeRoot(from, to)
+		return [][]byte{r[:]}
+	}
+	k := split(to - from)
+	if m <= k {
+		r := t.subtreeRoot(from+k, to)
+		return append(t.subproof(m, from, from+k), r[:])
+	}
+	r := t.subtreeRoot(from, from+k)
+	return append(t.subproof(m-k, from+k, to), r[:])
+}
+
+// split returns the largest power of 2 smaller than `n`
+func split(n uint64) uint64 {
+	return 1 << (bits.Len64(n-1) - 1)
+}
+
+func nodeHash(left, right [sha256.Size]byte) [sha256.Size]byte {
+	b := append([]byte{1}, left[:]...)
+	return sha256.Sum256(append(b, right[:]...))
+}
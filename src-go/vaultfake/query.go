@@ -0,0 +1,229 @@
+package vaultfake
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+)
+
+// search returns the documents matching the query in the query order, documents are matched by their latest revision.
+// Expressions are joined with OR and field comparisons inside an expression are joined with AND.
+func (c *collection) search(query *Query) ([]*document, *errReply) {
+	if err := c.checkQueryFields(query); err != nil {
+		return nil, err
+	}
+	var docs []*document
+	for _, d := range c.documents {
+		ok, err := matches(d.latest(), query)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			docs = append(docs, d)
+		}
+	}
+	if query == nil {
+		return docs, nil
+	}
+
+	if query.OrderBy != nil {
+		orderBy := *query.OrderBy
+		sort.SliceStable(docs, func(i, j int) bool {
+			for _, o := range orderBy {
+				cmp := compare(docs[i].latest()[o.Field], docs[j].latest()[o.Field])
+				if cmp == 0 {
+					continue
+				}
+				if o.Desc {
+					return cmp > 0
+				}
+				return cmp < 0
+			}
+			return false
+		})
+	}
+	if query.Limit != nil && *query.Limit > 0 && *query.Limit < len(docs) {
+		docs = docs[:*query.Limit]
+	}
+	return docs, nil
+}
+
+// checkQueryFields rejects the queries on the fields outside of the schema as Vault does,
+// the fields of the schema are the columns of the SQL table of the collection
+func (c *collection) checkQueryFields(query *Query) *errReply {
+	if query == nil {
+		return nil
+	}
+	var fields []string
+	if query.Expressions != nil {
+		for _, expr := range *query.Expressions {
+			if expr.FieldComparisons != nil {
+				for _, fc := range *expr.FieldComparisons {
+					fields = append(fields, fc.Field)
+				}
+			}
+		}
+	}
+	if query.OrderBy != nil {
+		for _, o := range *query.OrderBy {
+			fields = append(fields, o.Field)
+		}
+	}
+	for _, f := range fields {
+		if !c.hasField(f) {
+			return errorf(http.StatusBadRequest, "column does not exist (%s)", f)
+		}
+	}
+	return nil
+}
+
+func (c *collection) hasField(name string) bool {
+	if name == c.idFieldName {
+		return true
+	}
+	for _, f := range c.fields {
+		if f.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func matches(doc Document, query *Query) (bool, *errReply) {
+	if query == nil || query.Expressions == nil || len(*query.Expressions) == 0 {
+		return true, nil
+	}
+	for _, expr := range *query.Expressions {
+		all := true
+		if expr.FieldComparisons != nil {
+			for _, fc := range *expr.FieldComparisons {
+				ok, err := compareField(doc[fc.Field], fc.Operator, fc.Value)
+				if err != nil {
+					return false, err
+				}
+				if !ok {
+					all = false
+					break
+				}
+			}
+		}
+		if all {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func compareField(value any, operator Operator, operand any) (bool, *errReply) {
+	switch operator {
+	case EQ:
+		return compare(value, operand) == 0, nil
+	case NE:
+		return compare(value, operand) != 0, nil
+	case LT:
+		return value != nil && compare(value, operand) < 0, nil
+	case LE:
+		return value != nil && compare(value, operand) <= 0, nil
+	case GT:
+		return value != nil && compare(value, operand) > 0, nil
+	case GE:
+		return value != nil && compare(value, operand) >= 0, nil
+	case LIKE:
+		pattern, ok := operand.(string)
+		if !ok {
+			return false, errorf(http.StatusBadRequest, "LIKE operand must be a string")
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return false, errorf(http.StatusBadRequest, "invalid LIKE pattern: %s", err)
+		}
+		s, ok := value.(string)
+		return ok && re.MatchString(s), nil
+	default:
+		return false, errorf(http.StatusBadRequest, "unknown operator %s", operator)
+	}
+}
+
+// compare orders JSON values: missing values go first, then booleans, numbers and strings
+func compare(a, b any) int {
+	ra, rb := rank(a), rank(b)
+	if ra != rb {
+		return ra - rb
+	}
+	switch a := a.(type) {
+	case bool:
+		switch {
+		case a == b.(bool):
+			return 0
+		case !a:
+			return -1
+		default:
+			return 1
+		}
+	case float64:
+		bf := toFloat(b)
+		switch {
+		case a < bf:
+			return -1
+		case a > bf:
+			return 1
+		default:
+			return 0
+		}
+	case string:
+		bs := b.(string)
+		switch {
+		case a < bs:
+			return -1
+		case a > bs:
+			return 1
+		default:
+			return 0
+		}
+	case nil:
+		return 0
+	default:
+		// objects and arrays are compared by their representation
+		as, bs := fmt.Sprint(a), fmt.Sprint(b)
+		switch {
+		case as < bs:
+			return -1
+		case as > bs:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+func rank(v any) int {
+	switch v.(type) {
+	case nil:
+		return 0
+	case bool:
+		return 1
+	case float64, int, int32, int64:
+		return 2
+	case string:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func toFloat(v any) float64 {
+	switch v := v.(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	}
+	return 0
+}
@@ -0,0 +1,846 @@
+// Package vaultfake provides an in-memory fake of the immudb Vault document API used by the vaultclient package.
+//
+// The server is meant to be started with httptest and used as VaultConfig.Host, so VaultStorage can be tested
+// end-to-end without any outside services:
+//
+//	fake := vaultfake.NewServer()
+//	ts := httptest.NewServer(fake)
+//	defer ts.Close()
+//	storage, err := server.NewVaultStorage(server.VaultConfig{Host: ts.URL, ApiKey: "test", ...})
+package vaultfake
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+	"github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultproof"
+)
+
+// MaxPerPage is the maximum page size accepted by search and audit requests
+const MaxPerPage = 100
+
+// Server is a fake Vault that keeps ledgers in memory, ledgers are created on the first use
+type Server struct {
+	mu       sync.Mutex
+	ledgers  map[string]*ledger
+	failures map[string][]int
+}
+
+type ledger struct {
+	name        string
+	collections map[string]*collection
+	txs         []tx
+	tree        linkingTree
+	searches    map[string]*search
+}
+
+// search is a search kept open with KeepOpen, it pages through the documents as they were when it started
+type search struct {
+	collection string
+	revisions  []DocumentAtRevision
+}
+
+// txVersion is the version of the transactions written by the fake
+const txVersion = 1
+
+// tx is a committed transaction, its hash is chained with the hash of the previous one
+// and it is linked with all the previous transactions in the binary linking tree
+type tx struct {
+	id      uint64
+	ts      int64
+	blTxId  uint64
+	blRoot  [sha256.Size]byte
+	prevAlh [sha256.Size]byte
+	eh      [sha256.Size]byte
+	alh     [sha256.Size]byte
+	entries []txEntry
+}
+
+type txEntry struct {
+	key    []byte
+	hValue [sha256.Size]byte
+	vLen   int32
+}
+
+type collection struct {
+	id          int64
+	name        string
+	fields      []Field
+	indexes     []Index
+	idFieldName string
+	documents   []*document
+	byId        map[string]*document
+}
+
+type document struct {
+	id        string
+	revisions []revision
+}
+
+type revision struct {
+	doc   Document
+	txId  uint64
+	value []byte
+}
+
+// errReply is returned by the handlers to reply with an error status
+type errReply struct {
+	status int
+	msg    string
+}
+
+func (e *errReply) Error() string {
+	return e.msg
+}
+
+func errorf(status int, format string, a ...any) *errReply {
+	return &errReply{status, fmt.Sprintf(format, a...)}
+}
+
+func NewServer() *Server {
+	return &Server{
+		ledgers:  map[string]*ledger{},
+		failures: map[string][]int{},
+	}
+}
+
+// FailNext makes the next request of the `operation` fail with the HTTP `status`. Operations are named
+// after the vaultclient methods without the WithResponse suffix, e.g. "SearchDocument" or "DocumentCreate".
+// Calling it several times queues up several failures.
+func (s *Server) FailNext(operation string, status int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[operation] = append(s.failures[operation], status)
+}
+
+// OpenSearches returns how many searches are kept open in all the ledgers
+func (s *Server) OpenSearches() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	open := 0
+	for _, l := range s.ledgers {
+		open += len(l.searches)
+	}
+	return open
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	operation, handler, params := s.route(r.Method, r.URL.Path)
+	if handler == nil {
+		writeJSON(w, http.StatusNotFound, errReplyBody(http.StatusNotFound, "no such endpoint"))
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if failures := s.failures[operation]; len(failures) > 0 {
+		s.failures[operation] = failures[1:]
+		writeJSON(w, failures[0], errReplyBody(failures[0], "injected failure"))
+		return
+	}
+
+	l, ok := s.ledgers[params[0]]
+	if !ok {
+		l = &ledger{name: params[0], collections: map[string]*collection{}, searches: map[string]*search{}}
+		s.ledgers[params[0]] = l
+	}
+
+	resp, err := handler(l, params[1:], r)
+	if err != nil {
+		writeJSON(w, err.status, errReplyBody(err.status, err.msg))
+		return
+	}
+	writeJSON(w, http.StatusOK, resp)
+}
+
+type handlerFunc func(l *ledger, params []string, r *http.Request) (any, *errReply)
+
+// route finds the handler for the request, params are the ledger name followed by the rest of path parameters
+func (s *Server) route(method string, path string) (string, handlerFunc, []string) {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	// the API may be served under a prefix, e.g. /ics/api/v1
+	for i, p := range parts {
+		if p == "ledger" {
+			parts = parts[i:]
+			break
+		}
+	}
+	if len(parts) < 3 || parts[0] != "ledger" {
+		return "", nil, nil
+	}
+	ledgerName, rest := parts[1], parts[2:]
+
+	switch {
+	case len(rest) == 1 && rest[0] == "state" && method == http.MethodGet:
+		return "GetCurrentState", s.getCurrentState, []string{ledgerName}
+	case len(rest) == 1 && rest[0] == "collections" && method == http.MethodGet:
+		return "CollectionsList", s.collectionsList, []string{ledgerName}
+	case len(rest) < 2 || rest[0] != "collection":
+		return "", nil, nil
+	}
+	collectionName, rest := rest[1], rest[2:]
+	params := []string{ledgerName, collectionName}
+
+	switch {
+	case len(rest) == 0 && method == http.MethodGet:
+		return "CollectionGet", s.collectionGet, params
+	case len(rest) == 0 && method == http.MethodPut:
+		return "CollectionCreate", s.collectionCreate, params
+	case len(rest) == 1 && rest[0] == "indexes" && method == http.MethodPost:
+		return "CreateIndex", s.createIndex, params
+	case len(rest) == 1 && rest[0] == "document" && method == http.MethodPut:
+		return "DocumentCreate", s.documentCreate, params
+	case len(rest) == 1 && rest[0] == "document" && method == http.MethodPost:
+		return "UpdateDocument", s.updateDocument, params
+	case len(rest) == 1 && rest[0] == "documents" && method == http.MethodPut:
+		return "DocumentCreateMany", s.documentCreateMany, params
+	case len(rest) == 2 && rest[0] == "documents" && rest[1] == "search" && method == http.MethodPost:
+		return "SearchDocument", s.searchDocument, params
+	case len(rest) == 2 && rest[0] == "documents" && rest[1] == "count" && method == http.MethodPost:
+		return "CountDocuments", s.countDocuments, params
+	case len(rest) == 3 && rest[0] == "document" && rest[2] == "audit" && method == http.MethodPost:
+		return "AuditDocument", s.auditDocument, append(params, rest[1])
+	case len(rest) == 3 && rest[0] == "document" && rest[2] == "audit" && method == http.MethodPut:
+		return "DiffDocument", s.diffDocument, append(params, rest[1])
+	case len(rest) == 3 && rest[0] == "document" && rest[2] == "proof" && method == http.MethodPost:
+		return "GetDocumentProof", s.getDocumentProof, append(params, rest[1])
+	}
+	return "", nil, nil
+}
+
+func (s *Server) getCurrentState(l *ledger, _ []string, _ *http.Request) (any, *errReply) {
+	txId, txHash := uint64(0), make([]byte, sha256.Size)
+	if len(l.txs) > 0 {
+		last := l.txs[len(l.txs)-1]
+		txId, txHash = last.id, last.alh[:]
+	}
+	return SchemaImmutableState{
+		Db:     &l.name,
+		TxId:   ptr(strconv.FormatUint(txId, 10)),
+		TxHash: &txHash,
+	}, nil
+}
+
+func (s *Server) collectionsList(l *ledger, _ []string, _ *http.Request) (any, *errReply) {
+	resp := CollectionListResponse{Collections: []Collection{}}
+	for _, c := range l.collections {
+		resp.Collections = append(resp.Collections, c.describe())
+	}
+	return resp, nil
+}
+
+func (s *Server) collectionGet(l *ledger, params []string, _ *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	return c.describe(), nil
+}
+
+func (s *Server) collectionCreate(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	var req CollectionCreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	if _, ok := l.collections[params[0]]; ok {
+		return nil, errorf(http.StatusConflict, "collection %s already exists", params[0])
+	}
+	c := &collection{
+		id:          int64(len(l.collections) + 1),
+		name:        params[0],
+		idFieldName: "_id",
+		byId:        map[string]*document{},
+	}
+	if req.IdFieldName != nil && *req.IdFieldName != "" {
+		c.idFieldName = *req.IdFieldName
+	}
+	if req.Fields != nil {
+		c.fields = *req.Fields
+	}
+	if req.Indexes != nil {
+		c.indexes = *req.Indexes
+	}
+	l.collections[c.name] = c
+	return map[string]any{}, nil
+}
+
+// createIndex indexes fields of the collection schema, as in Vault the fields can't be added to the schema later
+func (s *Server) createIndex(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	var req IndexCreateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	if len(req.Fields) == 0 {
+		return nil, errorf(http.StatusBadRequest, "no fields specified")
+	}
+	for _, f := range req.Fields {
+		if !c.hasField(f) || f == c.idFieldName {
+			return nil, errorf(http.StatusBadRequest, "field %s is not in the schema of collection %s", f, c.name)
+		}
+	}
+	for _, index := range c.indexes {
+		if slices.Equal(index.Fields, req.Fields) {
+			return nil, errorf(http.StatusConflict, "index already exists")
+		}
+	}
+	index := Index{Fields: req.Fields, IsUnique: req.IsUnique}
+	if index.IsUnique {
+		probe := &collection{indexes: []Index{index}}
+		for i, a := range c.documents {
+			for _, b := range c.documents[i+1:] {
+				if probe.sameUniqueKey(a.latest(), b.latest()) {
+					return nil, errorf(http.StatusConflict, "duplicate key")
+				}
+			}
+		}
+	}
+	c.indexes = append(c.indexes, index)
+	return map[string]any{}, nil
+}
+
+func (s *Server) documentCreate(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	var doc Document
+	if err := decodeJSON(r, &doc); err != nil {
+		return nil, err
+	}
+	ids, txId, err := l.insert(c, []Document{doc})
+	if err != nil {
+		return nil, err
+	}
+	return DocumentInsertResponse{DocumentId: ids[0], TransactionId: ptr(strconv.FormatUint(txId, 10))}, nil
+}
+
+func (s *Server) documentCreateMany(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	var req DocumentInsertManyRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	if len(req.Documents) == 0 {
+		return nil, errorf(http.StatusBadRequest, "no documents to insert")
+	}
+	ids, txId, err := l.insert(c, req.Documents)
+	if err != nil {
+		return nil, err
+	}
+	return DocumentInsertManyResponse{DocumentIds: ids, TransactionId: ptr(strconv.FormatUint(txId, 10))}, nil
+}
+
+func (s *Server) updateDocument(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	var req DocumentUpdateRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	docs, err := c.search(&req.Query)
+	if err != nil {
+		return nil, err
+	}
+	if len(docs) == 0 {
+		return nil, errorf(http.StatusNotFound, "document not found")
+	}
+	d := docs[0]
+
+	doc := copyDocument(req.Document)
+	doc[c.idFieldName] = d.id
+	if err := c.checkUnique(doc, d); err != nil {
+		return nil, err
+	}
+	t, err := l.commit(c, []*document{d}, []Document{doc})
+	if err != nil {
+		return nil, err
+	}
+	return DocumentUpdateResponse{
+		DocumentId:    d.id,
+		Revision:      strconv.Itoa(len(d.revisions)),
+		TransactionId: strconv.FormatUint(t.id, 10),
+	}, nil
+}
+
+// searchDocument pages through the documents matching the query. If KeepOpen is set the search is kept open
+// and its id is returned until the last page is read, the later pages of the search are read with the id
+// from the snapshot of the documents taken when the search started, as Vault does.
+func (s *Server) searchDocument(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	var req DocumentSearchRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	if err := checkPage(req.Page, req.PerPage); err != nil {
+		return nil, err
+	}
+
+	var searchId string
+	var found *search
+	if req.SearchId != nil && *req.SearchId != "" {
+		if req.Query != nil {
+			return nil, errorf(http.StatusBadRequest, "query can't be set along with the search id")
+		}
+		searchId = *req.SearchId
+		var ok bool
+		if found, ok = l.searches[searchId]; !ok || found.collection != c.name {
+			return nil, errorf(http.StatusNotFound, "search %s not found", searchId)
+		}
+	} else {
+		docs, err := c.search(req.Query)
+		if err != nil {
+			return nil, err
+		}
+		found = &search{collection: c.name, revisions: make([]DocumentAtRevision, 0, len(docs))}
+		for _, d := range docs {
+			found.revisions = append(found.revisions, d.atRevision(len(d.revisions)))
+		}
+	}
+
+	resp := DocumentSearchResponse{Page: req.Page, PerPage: req.PerPage, Revisions: []DocumentAtRevision{}}
+	for _, rev := range page(found.revisions, req.Page, req.PerPage) {
+		rev.Document = copyDocument(rev.Document)
+		resp.Revisions = append(resp.Revisions, rev)
+	}
+	// the search is over once a page comes out short
+	if req.KeepOpen == nil || !*req.KeepOpen || len(resp.Revisions) < req.PerPage {
+		delete(l.searches, searchId)
+		return resp, nil
+	}
+	if searchId == "" {
+		searchId = l.newDocumentId()
+		l.searches[searchId] = found
+	}
+	resp.SearchId = searchId
+	return resp, nil
+}
+
+func (s *Server) countDocuments(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	var req DocumentCountRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	docs, err := c.search(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	return DocumentsCountResponse{Collection: c.name, Count: len(docs)}, nil
+}
+
+func (s *Server) auditDocument(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	d, err := c.document(params[1])
+	if err != nil {
+		return nil, err
+	}
+	var req DocumentAuditRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	if err := checkPage(req.Page, req.PerPage); err != nil {
+		return nil, err
+	}
+	revs := make([]int, 0, len(d.revisions))
+	for i := range d.revisions {
+		revs = append(revs, i+1)
+	}
+	if req.Desc {
+		for i, j := 0, len(revs)-1; i < j; i, j = i+1, j-1 {
+			revs[i], revs[j] = revs[j], revs[i]
+		}
+	}
+	resp := DocumentAuditResponse{Revisions: []DocumentAtRevision{}}
+	for _, rev := range page(revs, req.Page, req.PerPage) {
+		resp.Revisions = append(resp.Revisions, d.atRevision(rev))
+	}
+	return resp, nil
+}
+
+// diffDocument compares every revision on the page with the previous one, the first revision is compared
+// with an empty document. Vault doesn't specify the format of the diff, the fake maps every changed field
+// to its old and new values and identifies the diff by the compared revisions, e.g. "1-2".
+func (s *Server) diffDocument(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	d, err := c.document(params[1])
+	if err != nil {
+		return nil, err
+	}
+	var req DocumentDiffRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+	if err := checkPage(req.Page, req.PerPage); err != nil {
+		return nil, err
+	}
+	revs := make([]int, 0, len(d.revisions))
+	for i := range d.revisions {
+		revs = append(revs, i+1)
+	}
+	if req.Desc != nil && *req.Desc {
+		for i, j := 0, len(revs)-1; i < j; i, j = i+1, j-1 {
+			revs[i], revs[j] = revs[j], revs[i]
+		}
+	}
+	resp := DocumentDiffResponse{Diffs: []DocumentDiff{}, Revisions: []DocumentAtRevision{}}
+	for _, rev := range page(revs, req.Page, req.PerPage) {
+		prev := Document{}
+		if rev > 1 {
+			prev = d.revisions[rev-2].doc
+		}
+		resp.Diffs = append(resp.Diffs, DocumentDiff{
+			Diff:    diffDocuments(prev, d.revisions[rev-1].doc),
+			DiffIds: fmt.Sprintf("%d-%d", rev-1, rev),
+		})
+		resp.Revisions = append(resp.Revisions, d.atRevision(rev))
+	}
+	return resp, nil
+}
+
+func (s *Server) getDocumentProof(l *ledger, params []string, r *http.Request) (any, *errReply) {
+	c, err := l.collection(params[0])
+	if err != nil {
+		return nil, err
+	}
+	d, err := c.document(params[1])
+	if err != nil {
+		return nil, err
+	}
+	var req DocumentProofRequest
+	if err := decodeJSON(r, &req); err != nil {
+		return nil, err
+	}
+
+	// the latest revision is proven unless the transaction is given
+	rev := d.revisions[len(d.revisions)-1]
+	if req.TransactionId != 0 {
+		found := false
+		for _, dr := range d.revisions {
+			if dr.txId == uint64(req.TransactionId) {
+				rev, found = dr, true
+			}
+		}
+		if !found {
+			return nil, errorf(http.StatusNotFound, "document revision at tx %d not found", req.TransactionId)
+		}
+	}
+	sinceId := uint64(1)
+	if req.ProofSinceTransactionId != nil && *req.ProofSinceTransactionId != 0 {
+		sinceId = uint64(*req.ProofSinceTransactionId)
+	}
+	if sinceId < 1 || sinceId > uint64(len(l.txs)) {
+		return nil, errorf(http.StatusBadRequest, "invalid proof since transaction id %d", sinceId)
+	}
+
+	// the dual proof links the transaction of the document with the one the proof is requested since,
+	// whichever of them is older is the source
+	t := l.txs[rev.txId-1]
+	source, target := l.txs[sinceId-1], t
+	if t.id < sinceId {
+		source, target = t, l.txs[sinceId-1]
+	}
+	dualProof := &SchemaDualProofV2{
+		SourceTxHeader: source.header(),
+		TargetTxHeader: target.header(),
+	}
+	if source.id != target.id {
+		dualProof.InclusionProof = ptr(l.tree.inclusionProof(source.id, target.blTxId))
+		dualProof.ConsistencyProof = ptr(l.tree.consistencyProof(max(1, source.blTxId), target.blTxId))
+	}
+
+	entries := make([]SchemaTxEntry, 0, len(t.entries))
+	for _, e := range t.entries {
+		entries = append(entries, SchemaTxEntry{
+			Key:    ptr(e.key),
+			HValue: ptr(e.hValue[:]),
+			VLen:   ptr(e.vLen),
+		})
+	}
+	return DocumentProofResponse{
+		CollectionId:    c.id,
+		Database:        l.name,
+		EncodedDocument: rev.value,
+		IdFieldName:     c.idFieldName,
+		VerifiableTx: SchemaVerifiableTxV2{
+			Tx: &SchemaTx{
+				Header:  t.header(),
+				Entries: &entries,
+			},
+			DualProof: dualProof,
+		},
+	}, nil
+}
+
+func (l *ledger) collection(name string) (*collection, *errReply) {
+	c, ok := l.collections[name]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "collection %s does not exist", name)
+	}
+	return c, nil
+}
+
+// insert adds new documents to the collection in a single transaction
+func (l *ledger) insert(c *collection, docs []Document) ([]string, uint64, *errReply) {
+	var newDocs []*document
+	var values []Document
+	for _, doc := range docs {
+		doc = copyDocument(doc)
+		id, ok := doc[c.idFieldName].(string)
+		if !ok || id == "" {
+			id = l.newDocumentId()
+			doc[c.idFieldName] = id
+		}
+		if _, exists := c.byId[id]; exists {
+			return nil, 0, errorf(http.StatusConflict, "document %s already exists", id)
+		}
+		d := &document{id: id}
+		// check the unique indexes against the stored documents and the rest of the batch
+		if err := c.checkUnique(doc, nil); err != nil {
+			return nil, 0, err
+		}
+		for i, other := range values {
+			if c.sameUniqueKey(doc, other) || newDocs[i].id == id {
+				return nil, 0, errorf(http.StatusConflict, "duplicate key in the batch")
+			}
+		}
+		newDocs = append(newDocs, d)
+		values = append(values, doc)
+	}
+
+	t, err := l.commit(c, newDocs, values)
+	if err != nil {
+		return nil, 0, err
+	}
+	ids := make([]string, 0, len(newDocs))
+	for _, d := range newDocs {
+		c.documents = append(c.documents, d)
+		c.byId[d.id] = d
+		ids = append(ids, d.id)
+	}
+	return ids, t.id, nil
+}
+
+// commit writes new revisions of the documents in a new transaction. The transaction is stored the way immudb
+// stores it, so the proofs of the fake can be verified as the proofs of Vault.
+func (l *ledger) commit(c *collection, docs []*document, values []Document) (tx, *errReply) {
+	t := tx{id: uint64(len(l.txs)) + 1, ts: time.Now().Unix()}
+	t.blTxId = t.id - 1
+	t.blRoot = l.tree.root(t.blTxId)
+	if len(l.txs) > 0 {
+		t.prevAlh = l.txs[len(l.txs)-1].alh
+	}
+	revisions := make([]revision, 0, len(docs))
+	for i, d := range docs {
+		values[i]["_vault_md"] = map[string]any{"creator": "a:fake", "ts": t.ts}
+		encoded, err := c.encodeDocument(d.id, values[i])
+		if err != nil {
+			return tx{}, errorf(http.StatusBadRequest, "invalid document: %s", err)
+		}
+		revisions = append(revisions, revision{doc: values[i], txId: t.id, value: encoded})
+		key, err := vaultproof.DocumentKey(c.id, d.id)
+		if err != nil {
+			return tx{}, errorf(http.StatusBadRequest, "invalid document: %s", err)
+		}
+		t.entries = append(t.entries, txEntry{
+			key:    key,
+			hValue: sha256.Sum256(encoded),
+			vLen:   int32(len(encoded)),
+		})
+	}
+	entries := make([]SchemaTxEntry, 0, len(t.entries))
+	for _, e := range t.entries {
+		entries = append(entries, SchemaTxEntry{Key: ptr(e.key), HValue: ptr(e.hValue[:])})
+	}
+	t.eh, _ = vaultproof.EntriesHash(entries, txVersion)
+	t.alh, _ = vaultproof.Alh(t.header())
+
+	for i, d := range docs {
+		d.revisions = append(d.revisions, revisions[i])
+	}
+	l.txs = append(l.txs, t)
+	l.tree.append(t.alh)
+	return t, nil
+}
+
+// newDocumentId generates an id the same way Vault does: timestamp, transaction id and a random counter
+func (l *ledger) newDocumentId() string {
+	var b [16]byte
+	binary.BigEndian.PutUint32(b[0:4], uint32(time.Now().Unix()))
+	binary.BigEndian.PutUint64(b[4:12], uint64(len(l.txs)))
+	_, _ = rand.Read(b[12:16])
+	return hex.EncodeToString(b[:])
+}
+
+func (t tx) header() *SchemaTxHeader {
+	return &SchemaTxHeader{
+		Id:       ptr(strconv.FormatUint(t.id, 10)),
+		Ts:       ptr(strconv.FormatInt(t.ts, 10)),
+		BlTxId:   ptr(strconv.FormatUint(t.blTxId, 10)),
+		BlRoot:   ptr(t.blRoot[:]),
+		PrevAlh:  ptr(t.prevAlh[:]),
+		EH:       ptr(t.eh[:]),
+		Nentries: ptr(int32(len(t.entries))),
+		Version:  ptr(int32(txVersion)),
+	}
+}
+
+func (c *collection) describe() Collection {
+	return Collection{
+		Name:        c.name,
+		IdFieldName: c.idFieldName,
+		Fields:      append([]Field{}, c.fields...),
+		Indexes:     append([]Index{}, c.indexes...),
+	}
+}
+
+func (c *collection) document(id string) (*document, *errReply) {
+	d, ok := c.byId[id]
+	if !ok {
+		return nil, errorf(http.StatusNotFound, "document %s not found", id)
+	}
+	return d, nil
+}
+
+// checkUnique checks the document against the unique indexes, `self` is skipped when updating a document
+func (c *collection) checkUnique(doc Document, self *document) *errReply {
+	for _, d := range c.documents {
+		if d != self && c.sameUniqueKey(doc, d.latest()) {
+			return errorf(http.StatusConflict, "duplicate key")
+		}
+	}
+	return nil
+}
+
+func (c *collection) sameUniqueKey(a, b Document) bool {
+	for _, index := range c.indexes {
+		if !index.IsUnique {
+			continue
+		}
+		same := true
+		for _, f := range index.Fields {
+			if compare(a[f], b[f]) != 0 {
+				same = false
+				break
+			}
+		}
+		if same {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *document) latest() Document {
+	return d.revisions[len(d.revisions)-1].doc
+}
+
+// atRevision returns the revision of the document, revisions are numbered from 1
+func (d *document) atRevision(rev int) DocumentAtRevision {
+	r := d.revisions[rev-1]
+	return DocumentAtRevision{
+		Document:      copyDocument(r.doc),
+		Revision:      strconv.Itoa(rev),
+		TransactionId: strconv.FormatUint(r.txId, 10),
+	}
+}
+
+func checkPage(pageNumber int, perPage int) *errReply {
+	if pageNumber < 1 {
+		return errorf(http.StatusBadRequest, "invalid page number %d", pageNumber)
+	}
+	if perPage < 1 || perPage > MaxPerPage {
+		return errorf(http.StatusBadRequest, "invalid page size %d", perPage)
+	}
+	return nil
+}
+
+func page[T any](items []T, pageNumber int, perPage int) []T {
+	start := (pageNumber - 1) * perPage
+	if start >= len(items) {
+		return nil
+	}
+	return items[start:min(start+perPage, len(items))]
+}
+
+// diffDocuments maps the fields that differ to their old and new values, missing values are left out
+func diffDocuments(from Document, to Document) map[string]interface{} {
+	diff := map[string]interface{}{}
+	for k, v := range to {
+		if old, ok := from[k]; !ok || !reflect.DeepEqual(old, v) {
+			change := map[string]interface{}{"new": v}
+			if ok {
+				change["old"] = old
+			}
+			diff[k] = change
+		}
+	}
+	for k, v := range from {
+		if _, ok := to[k]; !ok {
+			diff[k] = map[string]interface{}{"old": v}
+		}
+	}
+	return diff
+}
+
+func copyDocument(doc Document) Document {
+	c := make(Document, len(doc))
+	for k, v := range doc {
+		c[k] = v
+	}
+	return c
+}
+
+func decodeJSON(r *http.Request, v any) *errReply {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errorf(http.StatusBadRequest, "invalid request body: %s", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func errReplyBody(status int, msg string) ErrReply {
+	return ErrReply{Code: status, Error: msg, Status: http.StatusText(status)}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
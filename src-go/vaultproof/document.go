@@ -0,0 +1,89 @@
+package vaultproof
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+)
+
+// Column ids of the SQL table of a collection, the columns of the indexed fields follow them
+const (
+	idColumn  = uint32(1)
+	docColumn = uint32(2)
+)
+
+// DecodeDocument decodes the encoded document of the proof. The encoding is the value of the SQL row of the document:
+// the number of values followed by the column id, the length and the value of every column. The id column holds
+// the raw id and the document column the whole document as a protobuf Struct, the other columns are the indexed
+// fields, which are copies of the fields of the document. The returned document is the one Vault stores,
+// it includes the id field and the fields set by Vault.
+func DecodeDocument(proof *DocumentProofResponse) (Document, error) {
+	if proof == nil {
+		return nil, fmt.Errorf("%w: no proof", ErrMalformedProof)
+	}
+	columns, err := decodeColumns(proof.EncodedDocument)
+	if err != nil {
+		return nil, err
+	}
+	rawId, ok := columns[idColumn]
+	if !ok {
+		return nil, fmt.Errorf("%w: encoded document has no id", ErrMalformedProof)
+	}
+	blob, ok := columns[docColumn]
+	if !ok {
+		return nil, fmt.Errorf("%w: encoded document has no document", ErrMalformedProof)
+	}
+	var s structpb.Struct
+	if err := proto.Unmarshal(blob, &s); err != nil {
+		return nil, fmt.Errorf("%w: bad encoded document: %s", ErrMalformedProof, err)
+	}
+	doc := s.AsMap()
+
+	if id, _ := doc[idFieldName(proof)].(string); id != hex.EncodeToString(rawId) {
+		return nil, fmt.Errorf("%w: id field of the document doesn't match the encoded id", ErrInvalidProof)
+	}
+	return doc, nil
+}
+
+func idFieldName(proof *DocumentProofResponse) string {
+	if proof.IdFieldName == "" {
+		return "_id"
+	}
+	return proof.IdFieldName
+}
+
+// decodeColumns returns the values of the encoded row by the column ids
+func decodeColumns(encoded []byte) (map[uint32][]byte, error) {
+	if len(encoded) < 4 {
+		return nil, fmt.Errorf("%w: encoded document is too short", ErrMalformedProof)
+	}
+	count := binary.BigEndian.Uint32(encoded)
+	b := encoded[4:]
+	columns := map[uint32][]byte{}
+	prevId := uint32(0)
+	for i := uint32(0); i < count; i++ {
+		if len(b) < 8 {
+			return nil, fmt.Errorf("%w: encoded document is truncated", ErrMalformedProof)
+		}
+		id, size := binary.BigEndian.Uint32(b), binary.BigEndian.Uint32(b[4:])
+		b = b[8:]
+		if id <= prevId {
+			return nil, fmt.Errorf("%w: columns of the encoded document are out of order", ErrMalformedProof)
+		}
+		if uint32(len(b)) < size {
+			return nil, fmt.Errorf("%w: encoded document is truncated", ErrMalformedProof)
+		}
+		columns[id] = b[:size]
+		b = b[size:]
+		prevId = id
+	}
+	if len(b) > 0 {
+		return nil, fmt.Errorf("%w: trailing bytes after the encoded document", ErrMalformedProof)
+	}
+	return columns, nil
+}
@@ -0,0 +1,116 @@
+package vaultproof
+
+import (
+	"crypto/sha256"
+)
+
+// Hashes of the nodes of the Merkle trees used by immudb, leaves and inner nodes are prefixed
+// with different bytes as described in RFC 6962
+const (
+	leafPrefix = byte(0)
+	nodePrefix = byte(1)
+)
+
+func leafHash(digest [sha256.Size]byte) [sha256.Size]byte {
+	var b [1 + sha256.Size]byte
+	b[0] = leafPrefix
+	copy(b[1:], digest[:])
+	return sha256.Sum256(b[:])
+}
+
+func nodeHash(left, right [sha256.Size]byte) [sha256.Size]byte {
+	var b [1 + 2*sha256.Size]byte
+	b[0] = nodePrefix
+	copy(b[1:], left[:])
+	copy(b[1+sha256.Size:], right[:])
+	return sha256.Sum256(b[:])
+}
+
+// merkleRoot returns the root of the tree of the transaction entries, an odd node of a level is promoted to the next one
+func merkleRoot(digests [][sha256.Size]byte) [sha256.Size]byte {
+	if len(digests) == 0 {
+		return sha256.Sum256(nil)
+	}
+	level := make([][sha256.Size]byte, len(digests))
+	for i, d := range digests {
+		level[i] = leafHash(d)
+	}
+	for len(level) > 1 {
+		next := level[:0]
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+			} else {
+				next = append(next, nodeHash(level[i], level[i+1]))
+			}
+		}
+		level = next
+	}
+	return level[0]
+}
+
+// verifyInclusion checks that `leaf` is the leaf number `i` of the tree of size `n` with the `root`,
+// leaves are numbered from 1 as transactions are. It is the algorithm of RFC 9162, section 2.1.3.2
+func verifyInclusion(proof [][sha256.Size]byte, i, n uint64, leaf, root [sha256.Size]byte) bool {
+	if i == 0 || i > n {
+		return false
+	}
+	fn, sn := i-1, n-1
+	r := leaf
+	for _, p := range proof {
+		if sn == 0 {
+			return false
+		}
+		if fn&1 == 1 || fn == sn {
+			r = nodeHash(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = nodeHash(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	return sn == 0 && r == root
+}
+
+// verifyConsistency checks that the tree of size `m` with `mRoot` is a prefix of the tree of size `n` with `nRoot`.
+// It is the algorithm of RFC 9162, section 2.1.4.2, except that immudb always puts the root of the subtree
+// the proof starts from at the beginning of the proof, even if it is the old root itself
+func verifyConsistency(proof [][sha256.Size]byte, m, n uint64, mRoot, nRoot [sha256.Size]byte) bool {
+	if m == 0 || m > n {
+		return false
+	}
+	if m == n {
+		return len(proof) == 0 && mRoot == nRoot
+	}
+	if len(proof) == 0 {
+		return false
+	}
+	fn, sn := m-1, n-1
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+	fr, sr := proof[0], proof[0]
+	for _, c := range proof[1:] {
+		if sn == 0 {
+			return false
+		}
+		if fn&1 == 1 || fn == sn {
+			fr = nodeHash(c, fr)
+			sr = nodeHash(c, sr)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			sr = nodeHash(sr, c)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	return sn == 0 && fr == mRoot && sr == nRoot
+}
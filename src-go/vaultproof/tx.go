@@ -0,0 +1,216 @@
+package vaultproof
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+)
+
+// Attribute codes of the transaction and entry metadata
+const (
+	truncatedTxIdAttr = byte(0)
+
+	deletedAttr      = byte(0)
+	expiresAtAttr    = byte(1)
+	nonIndexableAttr = byte(2)
+)
+
+// txHeader is the parsed SchemaTxHeader
+type txHeader struct {
+	id       uint64
+	ts       int64
+	blTxId   uint64
+	blRoot   [sha256.Size]byte
+	prevAlh  [sha256.Size]byte
+	version  int
+	metadata []byte
+	nEntries int
+	eh       [sha256.Size]byte
+}
+
+func parseTxHeader(h *SchemaTxHeader) (txHeader, error) {
+	if h == nil {
+		return txHeader{}, fmt.Errorf("%w: missing transaction header", ErrMalformedProof)
+	}
+	var hdr txHeader
+	var err error
+	if hdr.id, err = parseUint(h.Id); err != nil {
+		return txHeader{}, fmt.Errorf("%w: bad transaction id: %s", ErrMalformedProof, err)
+	}
+	if hdr.id == 0 {
+		return txHeader{}, fmt.Errorf("%w: transaction id is 0", ErrMalformedProof)
+	}
+	ts, err := parseUint(h.Ts)
+	if err != nil {
+		return txHeader{}, fmt.Errorf("%w: bad transaction timestamp: %s", ErrMalformedProof, err)
+	}
+	hdr.ts = int64(ts)
+	if hdr.blTxId, err = parseUint(h.BlTxId); err != nil {
+		return txHeader{}, fmt.Errorf("%w: bad binary linking transaction id: %s", ErrMalformedProof, err)
+	}
+	if hdr.blRoot, err = digest(h.BlRoot); err != nil {
+		return txHeader{}, err
+	}
+	if hdr.prevAlh, err = digest(h.PrevAlh); err != nil {
+		return txHeader{}, err
+	}
+	if hdr.eh, err = digest(h.EH); err != nil {
+		return txHeader{}, err
+	}
+	if h.Version != nil {
+		hdr.version = int(*h.Version)
+	}
+	if h.Nentries != nil {
+		hdr.nEntries = int(*h.Nentries)
+	}
+	if h.Metadata != nil && h.Metadata.TruncatedTxID != nil {
+		truncatedTxId, err := parseUint(h.Metadata.TruncatedTxID)
+		if err != nil {
+			return txHeader{}, fmt.Errorf("%w: bad truncated transaction id: %s", ErrMalformedProof, err)
+		}
+		if truncatedTxId > 0 {
+			hdr.metadata = binary.BigEndian.AppendUint64([]byte{truncatedTxIdAttr}, truncatedTxId)
+		}
+	}
+	return hdr, nil
+}
+
+// Alh returns the accumulative linear hash of the transaction, it is the TxHash of the ledger state
+// right after the transaction
+func Alh(header *SchemaTxHeader) ([sha256.Size]byte, error) {
+	hdr, err := parseTxHeader(header)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return hdr.alh()
+}
+
+// alh is sha256(id + prevAlh + sha256(ts + version + metadata + nEntries + eh + blTxId + blRoot))
+func (hdr txHeader) alh() ([sha256.Size]byte, error) {
+	var inner []byte
+	inner = binary.BigEndian.AppendUint64(inner, uint64(hdr.ts))
+	inner = binary.BigEndian.AppendUint16(inner, uint16(hdr.version))
+	switch hdr.version {
+	case 0:
+		if len(hdr.metadata) > 0 {
+			return [sha256.Size]byte{}, fmt.Errorf("%w: metadata in a version 0 transaction", ErrMalformedProof)
+		}
+		inner = binary.BigEndian.AppendUint16(inner, uint16(hdr.nEntries))
+	case 1:
+		inner = binary.BigEndian.AppendUint16(inner, uint16(len(hdr.metadata)))
+		inner = append(inner, hdr.metadata...)
+		inner = binary.BigEndian.AppendUint32(inner, uint32(hdr.nEntries))
+	default:
+		return [sha256.Size]byte{}, fmt.Errorf("%w: unsupported transaction version %d", ErrMalformedProof, hdr.version)
+	}
+	inner = append(inner, hdr.eh[:]...)
+	inner = binary.BigEndian.AppendUint64(inner, hdr.blTxId)
+	inner = append(inner, hdr.blRoot[:]...)
+	innerHash := sha256.Sum256(inner)
+
+	var b []byte
+	b = binary.BigEndian.AppendUint64(b, hdr.id)
+	b = append(b, hdr.prevAlh[:]...)
+	b = append(b, innerHash[:]...)
+	return sha256.Sum256(b), nil
+}
+
+// EntriesHash returns the root of the Merkle tree of the transaction entries, it is the EH of the transaction header
+func EntriesHash(entries []SchemaTxEntry, version int) ([sha256.Size]byte, error) {
+	digests := make([][sha256.Size]byte, 0, len(entries))
+	for _, e := range entries {
+		d, err := entryDigest(e, version)
+		if err != nil {
+			return [sha256.Size]byte{}, err
+		}
+		digests = append(digests, d)
+	}
+	return merkleRoot(digests), nil
+}
+
+func entryDigest(e SchemaTxEntry, version int) ([sha256.Size]byte, error) {
+	if e.Key == nil {
+		return [sha256.Size]byte{}, fmt.Errorf("%w: entry without a key", ErrMalformedProof)
+	}
+	key := *e.Key
+	hValue, err := digest(e.HValue)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	md := entryMetadata(e.Metadata)
+
+	var b []byte
+	switch version {
+	case 0:
+		if len(md) > 0 {
+			return [sha256.Size]byte{}, fmt.Errorf("%w: metadata in a version 0 transaction", ErrMalformedProof)
+		}
+	case 1:
+		b = binary.BigEndian.AppendUint16(b, uint16(len(md)))
+		b = append(b, md...)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(key)))
+	default:
+		return [sha256.Size]byte{}, fmt.Errorf("%w: unsupported transaction version %d", ErrMalformedProof, version)
+	}
+	b = append(b, key...)
+	b = append(b, hValue[:]...)
+	return sha256.Sum256(b), nil
+}
+
+// entryMetadata serializes the metadata of an entry, attributes go in the order of their codes
+func entryMetadata(md *SchemaKVMetadata) []byte {
+	if md == nil {
+		return nil
+	}
+	var b []byte
+	if md.Deleted != nil && *md.Deleted {
+		b = append(b, deletedAttr)
+	}
+	if md.Expiration != nil && md.Expiration.ExpiresAt != nil {
+		expiresAt, _ := strconv.ParseInt(*md.Expiration.ExpiresAt, 10, 64)
+		b = binary.BigEndian.AppendUint64(append(b, expiresAtAttr), uint64(expiresAt))
+	}
+	if md.NonIndexable != nil && *md.NonIndexable {
+		b = append(b, nonIndexableAttr)
+	}
+	return b
+}
+
+// parseUint parses uint64 values, which are encoded as strings in the Vault JSON, missing values are 0
+func parseUint(s *string) (uint64, error) {
+	if s == nil || *s == "" {
+		return 0, nil
+	}
+	return strconv.ParseUint(*s, 10, 64)
+}
+
+// digest converts a hash from the Vault JSON, missing hashes are all zeros
+func digest(b *[]byte) ([sha256.Size]byte, error) {
+	var d [sha256.Size]byte
+	if b == nil || len(*b) == 0 {
+		return d, nil
+	}
+	if len(*b) != sha256.Size {
+		return d, fmt.Errorf("%w: hash of %d bytes", ErrMalformedProof, len(*b))
+	}
+	copy(d[:], *b)
+	return d, nil
+}
+
+func digests(terms *[][]byte) ([][sha256.Size]byte, error) {
+	if terms == nil {
+		return nil, nil
+	}
+	ds := make([][sha256.Size]byte, 0, len(*terms))
+	for _, t := range *terms {
+		d, err := digest(&t)
+		if err != nil {
+			return nil, err
+		}
+		ds = append(ds, d)
+	}
+	return ds, nil
+}
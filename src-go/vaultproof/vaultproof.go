@@ -0,0 +1,259 @@
+// Package vaultproof verifies the cryptographic proofs returned by immudb Vault without contacting Vault.
+//
+// A document proof consists of the encoded document, the transaction that wrote the document and a dual proof
+// linking that transaction with a previously trusted state of the ledger. Verification recomputes the hashes of
+// the document and the transaction and checks the Merkle tree proofs, so a valid proof shows that the document
+// is in the ledger as it is and that the ledger history up to the trusted state wasn't rewritten:
+//
+//	state, err := vaultproof.VerifyDocumentProof(proof, documentId, trustedState)
+//	if err != nil {
+//		// the document or the ledger history was tampered with
+//	}
+//	// state is the newest verified state, it can be trusted from now on
+//	doc, err := vaultproof.DecodeDocument(proof)
+//	// doc is the proven content of the document
+//
+// Only the transaction metadata exposed by the Vault API is taken into account, the proofs of transactions
+// with other metadata attributes don't verify.
+package vaultproof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+)
+
+// ErrInvalidProof is returned when a proof doesn't verify, the returned errors wrap it with the reason
+var ErrInvalidProof = errors.New("invalid proof")
+
+// ErrMalformedProof wraps ErrInvalidProof for the proofs that can't be decoded, e.g. with missing fields or bad
+// encodings. Such a proof may be garbled on its way and shows nothing about the ledger, unlike a proof that decodes
+// but doesn't verify
+var ErrMalformedProof = fmt.Errorf("%w: malformed", ErrInvalidProof)
+
+// Key encoding of the documents, documents are rows of a SQL table of the collection
+const (
+	documentPrefix      = byte(3)
+	rowPrefix           = "R."
+	databaseId          = uint32(1)
+	primaryIndexId      = uint32(0)
+	maxDocumentIdLength = 32
+	notNullPrefix       = byte(0x80)
+)
+
+// DocumentKey returns the key of the transaction entry that stores the document of the collection
+func DocumentKey(collectionId int64, documentId string) ([]byte, error) {
+	id, err := hex.DecodeString(documentId)
+	if err != nil {
+		return nil, fmt.Errorf("bad document id %q: %w", documentId, err)
+	}
+	if len(id) > maxDocumentIdLength {
+		return nil, fmt.Errorf("bad document id %q: too long", documentId)
+	}
+	key := []byte{documentPrefix}
+	key = append(key, rowPrefix...)
+	key = binary.BigEndian.AppendUint32(key, databaseId)
+	key = binary.BigEndian.AppendUint32(key, uint32(collectionId))
+	key = binary.BigEndian.AppendUint32(key, primaryIndexId)
+	// the primary key value is padded to the maximum length and followed by its actual length
+	key = append(key, notNullPrefix)
+	key = append(key, id...)
+	key = append(key, make([]byte, maxDocumentIdLength-len(id))...)
+	key = binary.BigEndian.AppendUint32(key, uint32(len(id)))
+	return key, nil
+}
+
+// VerifyDocumentProof checks that the proof is valid for the document with the id and is consistent
+// with the `trusted` state. If `trusted` is nil the proof must start from the first transaction of the ledger.
+// The encoded document must be well-formed and have the id, its content is returned by DecodeDocument.
+// The state of the ledger proven by the proof is returned, it is the newest of the trusted state and the
+// state after the transaction of the document.
+func VerifyDocumentProof(proof *DocumentProofResponse, documentId string, trusted *SchemaImmutableState) (*SchemaImmutableState, error) {
+	if proof == nil {
+		return nil, fmt.Errorf("%w: no proof", ErrMalformedProof)
+	}
+	key, err := DocumentKey(proof.CollectionId, documentId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformedProof, err)
+	}
+	doc, err := DecodeDocument(proof)
+	if err != nil {
+		return nil, err
+	}
+	if doc[idFieldName(proof)] != documentId {
+		return nil, fmt.Errorf("%w: proof is for another document", ErrInvalidProof)
+	}
+
+	vtx := proof.VerifiableTx
+	if vtx.Tx == nil || vtx.Tx.Entries == nil {
+		return nil, fmt.Errorf("%w: missing transaction entries", ErrMalformedProof)
+	}
+
+	// the document must be written exactly once by the transaction
+	found := 0
+	hValue := sha256.Sum256(proof.EncodedDocument)
+	for _, e := range *vtx.Tx.Entries {
+		if e.Key == nil || !bytes.Equal(*e.Key, key) {
+			continue
+		}
+		if e.HValue == nil || !bytes.Equal(*e.HValue, hValue[:]) {
+			return nil, fmt.Errorf("%w: hash of the document doesn't match the transaction entry", ErrInvalidProof)
+		}
+		found++
+	}
+	if found != 1 {
+		return nil, fmt.Errorf("%w: document is written %d times by the transaction", ErrInvalidProof, found)
+	}
+
+	state, err := VerifyTx(&vtx, trusted)
+	if err != nil {
+		return nil, err
+	}
+	state.Db = &proof.Database
+	return state, nil
+}
+
+// VerifyTx checks that the entries of the transaction match its header and the dual proof links the transaction
+// with the `trusted` state. The newest of the trusted state and the state after the transaction is returned.
+func VerifyTx(vtx *SchemaVerifiableTxV2, trusted *SchemaImmutableState) (*SchemaImmutableState, error) {
+	if vtx == nil || vtx.Tx == nil || vtx.DualProof == nil {
+		return nil, fmt.Errorf("%w: missing transaction or dual proof", ErrMalformedProof)
+	}
+	hdr, err := parseTxHeader(vtx.Tx.Header)
+	if err != nil {
+		return nil, err
+	}
+	var entries []SchemaTxEntry
+	if vtx.Tx.Entries != nil {
+		entries = *vtx.Tx.Entries
+	}
+	if len(entries) != hdr.nEntries {
+		return nil, fmt.Errorf("%w: transaction has %d entries, header says %d", ErrInvalidProof, len(entries), hdr.nEntries)
+	}
+	eh, err := EntriesHash(entries, hdr.version)
+	if err != nil {
+		return nil, err
+	}
+	if eh != hdr.eh {
+		return nil, fmt.Errorf("%w: entries don't match the transaction header", ErrInvalidProof)
+	}
+	txAlh, err := hdr.alh()
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := parseTxHeader(vtx.DualProof.SourceTxHeader)
+	if err != nil {
+		return nil, err
+	}
+	target, err := parseTxHeader(vtx.DualProof.TargetTxHeader)
+	if err != nil {
+		return nil, err
+	}
+	sourceAlh, err := source.alh()
+	if err != nil {
+		return nil, err
+	}
+	targetAlh, err := target.alh()
+	if err != nil {
+		return nil, err
+	}
+
+	if source.id == target.id && sourceAlh != targetAlh {
+		return nil, fmt.Errorf("%w: different headers of the same transaction", ErrInvalidProof)
+	}
+
+	// the transaction must be one of the ends of the dual proof and the trusted state the other one
+	switch {
+	case hdr.id == source.id && txAlh == sourceAlh:
+	case hdr.id == target.id && txAlh == targetAlh:
+	default:
+		return nil, fmt.Errorf("%w: transaction doesn't match the dual proof", ErrInvalidProof)
+	}
+	trustedTxId, trustedTxHash, err := parseState(trusted)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case trustedTxId == 0:
+		if source.id != 1 {
+			return nil, fmt.Errorf("%w: without a trusted state the proof must start from the first transaction", ErrInvalidProof)
+		}
+	case trustedTxId == source.id && trustedTxHash == sourceAlh:
+	case trustedTxId == target.id && trustedTxHash == targetAlh:
+	default:
+		return nil, fmt.Errorf("%w: trusted state %d doesn't match the dual proof", ErrInvalidProof, trustedTxId)
+	}
+
+	inclusionProof, err := digests(vtx.DualProof.InclusionProof)
+	if err != nil {
+		return nil, err
+	}
+	consistencyProof, err := digests(vtx.DualProof.ConsistencyProof)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyDualProof(source, target, sourceAlh, inclusionProof, consistencyProof); err != nil {
+		return nil, err
+	}
+
+	return &SchemaImmutableState{
+		TxId:      ptr(strconv.FormatUint(target.id, 10)),
+		TxHash:    ptr(targetAlh[:]),
+		Signature: vtx.Signature,
+	}, nil
+}
+
+// verifyDualProof checks that the source transaction is included in the binary linking tree of the target one
+// and that the tree of the source transaction is a prefix of the tree of the target one.
+// Every transaction links all the previous ones in the tree, so the tree of a transaction has id-1 leaves.
+func verifyDualProof(source, target txHeader, sourceAlh [sha256.Size]byte, inclusionProof, consistencyProof [][sha256.Size]byte) error {
+	if source.id > target.id {
+		return fmt.Errorf("%w: source transaction is newer than the target one", ErrInvalidProof)
+	}
+	if source.blTxId != source.id-1 || target.blTxId != target.id-1 {
+		return fmt.Errorf("%w: unexpected binary linking of the transactions", ErrInvalidProof)
+	}
+	if source.id == target.id {
+		return nil
+	}
+
+	if !verifyInclusion(inclusionProof, source.id, target.blTxId, leafHash(sourceAlh), target.blRoot) {
+		return fmt.Errorf("%w: inclusion proof doesn't verify", ErrInvalidProof)
+	}
+
+	// the tree of the first transaction is empty, so its own leaf is used instead
+	from, fromRoot := source.blTxId, source.blRoot
+	if source.id == 1 {
+		from, fromRoot = 1, leafHash(sourceAlh)
+	}
+	if !verifyConsistency(consistencyProof, from, target.blTxId, fromRoot, target.blRoot) {
+		return fmt.Errorf("%w: consistency proof doesn't verify", ErrInvalidProof)
+	}
+	return nil
+}
+
+func parseState(state *SchemaImmutableState) (uint64, [sha256.Size]byte, error) {
+	if state == nil {
+		return 0, [sha256.Size]byte{}, nil
+	}
+	txId, err := parseUint(state.TxId)
+	if err != nil {
+		return 0, [sha256.Size]byte{}, fmt.Errorf("bad trusted state: %w", err)
+	}
+	txHash, err := digest(state.TxHash)
+	if err != nil {
+		return 0, [sha256.Size]byte{}, fmt.Errorf("bad trusted state: %w", err)
+	}
+	return txId, txHash, nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,211 @@
+package vaultproof
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"testing"
+
+	. "github.com/ilyatikhonov/codenotary-vault-ledger/src-go/vaultclient"
+)
+
+// proofVector is a document proof returned by immudb with the trusted state it was requested since
+// and the state it proves
+type proofVector struct {
+	Name       string                `json:"name"`
+	DocumentId string                `json:"documentId"`
+	Trusted    *SchemaImmutableState `json:"trusted"`
+	Proven     SchemaImmutableState  `json:"proven"`
+	Proof      DocumentProofResponse `json:"proof"`
+}
+
+// loadVectors reads the proofs of testdata/immudb-proofs.json. They were produced by ProofDocument of immudb v1.9.7,
+// the engine Vault runs on, for a collection of 12 documents written one per transaction, and marshalled with
+// protojson under the field names of the Vault API. Every call returns a fresh copy to tamper with.
+func loadVectors(t *testing.T) []proofVector {
+	t.Helper()
+	data, err := os.ReadFile("testdata/immudb-proofs.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vectors []proofVector
+	if err := json.Unmarshal(data, &vectors); err != nil {
+		t.Fatal(err)
+	}
+	return vectors
+}
+
+func TestVerifyDocumentProof(t *testing.T) {
+	for _, v := range loadVectors(t) {
+		t.Run(v.Name, func(t *testing.T) {
+			state, err := VerifyDocumentProof(&v.Proof, v.DocumentId, v.Trusted)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *state.TxId != *v.Proven.TxId || !bytes.Equal(*state.TxHash, *v.Proven.TxHash) {
+				t.Fatalf("proven state %s doesn't match the expected state %s", *state.TxId, *v.Proven.TxId)
+			}
+			if *state.Db != v.Proof.Database {
+				t.Fatalf("proven state is of ledger %q, expected %q", *state.Db, v.Proof.Database)
+			}
+			doc, err := DecodeDocument(&v.Proof)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doc["_id"] != v.DocumentId || doc["account_number"] != "1001" {
+				t.Fatalf("unexpected document %v", doc)
+			}
+		})
+	}
+}
+
+func TestVerifyDocumentProofTampered(t *testing.T) {
+	tests := []struct {
+		name string
+		// vector is the index of the vector to tamper with
+		vector int
+		tamper func(v *proofVector)
+	}{
+		{
+			name:   "document changed",
+			vector: 1,
+			tamper: func(v *proofVector) {
+				v.Proof.EncodedDocument = bytes.Replace(v.Proof.EncodedDocument, []byte("DEPOSIT"), []byte("DEPOSAT"), 1)
+			},
+		},
+		{
+			name:   "entry changed with the document",
+			vector: 1,
+			tamper: func(v *proofVector) {
+				v.Proof.EncodedDocument = bytes.Replace(v.Proof.EncodedDocument, []byte("DEPOSIT"), []byte("DEPOSAT"), 1)
+				hValue := sha256.Sum256(v.Proof.EncodedDocument)
+				(*v.Proof.VerifiableTx.Tx.Entries)[0].HValue = ptr(hValue[:])
+			},
+		},
+		{
+			name:   "other document",
+			vector: 1,
+			tamper: func(v *proofVector) {
+				v.DocumentId = loadVectors(t)[0].DocumentId
+			},
+		},
+		{
+			name:   "trusted state of another transaction",
+			vector: 1,
+			tamper: func(v *proofVector) {
+				id, _ := strconv.Atoi(*v.Trusted.TxId)
+				v.Trusted.TxId = ptr(strconv.Itoa(id + 1))
+			},
+		},
+		{
+			name:   "trusted state with another hash",
+			vector: 1,
+			tamper: func(v *proofVector) {
+				(*v.Trusted.TxHash)[0] ^= 1
+			},
+		},
+		{
+			name:   "newer trusted state with another hash",
+			vector: 2,
+			tamper: func(v *proofVector) {
+				(*v.Trusted.TxHash)[0] ^= 1
+			},
+		},
+		{
+			name:   "no trusted state for a proof from a later transaction",
+			vector: 1,
+			tamper: func(v *proofVector) {
+				v.Trusted = nil
+			},
+		},
+		{
+			name:   "consistency path truncated",
+			vector: 1,
+			tamper: func(v *proofVector) {
+				path := *v.Proof.VerifiableTx.DualProof.ConsistencyProof
+				v.Proof.VerifiableTx.DualProof.ConsistencyProof = ptr(path[:len(path)-1])
+			},
+		},
+		{
+			name:   "consistency path changed",
+			vector: 2,
+			tamper: func(v *proofVector) {
+				(*v.Proof.VerifiableTx.DualProof.ConsistencyProof)[0][0] ^= 1
+			},
+		},
+		{
+			name:   "inclusion path truncated",
+			vector: 1,
+			tamper: func(v *proofVector) {
+				path := *v.Proof.VerifiableTx.DualProof.InclusionProof
+				v.Proof.VerifiableTx.DualProof.InclusionProof = ptr(path[:len(path)-1])
+			},
+		},
+		{
+			name:   "target header changed",
+			vector: 0,
+			tamper: func(v *proofVector) {
+				(*v.Proof.VerifiableTx.DualProof.TargetTxHeader.BlRoot)[0] ^= 1
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := loadVectors(t)[tt.vector]
+			tt.tamper(&v)
+			_, err := VerifyDocumentProof(&v.Proof, v.DocumentId, v.Trusted)
+			if !errors.Is(err, ErrInvalidProof) {
+				t.Fatalf("expected ErrInvalidProof, got %v", err)
+			}
+			if errors.Is(err, ErrMalformedProof) {
+				t.Fatalf("tampered proof is reported as malformed: %v", err)
+			}
+		})
+	}
+}
+
+func TestVerifyDocumentProofMalformed(t *testing.T) {
+	tests := []struct {
+		name   string
+		garble func(v *proofVector)
+	}{
+		{
+			name: "no dual proof",
+			garble: func(v *proofVector) {
+				v.Proof.VerifiableTx.DualProof = nil
+			},
+		},
+		{
+			name: "encoded document truncated",
+			garble: func(v *proofVector) {
+				v.Proof.EncodedDocument = v.Proof.EncodedDocument[:len(v.Proof.EncodedDocument)-1]
+			},
+		},
+		{
+			name: "transaction id not a number",
+			garble: func(v *proofVector) {
+				v.Proof.VerifiableTx.Tx.Header.Id = ptr("one")
+			},
+		},
+		{
+			name: "short hash",
+			garble: func(v *proofVector) {
+				hash := *v.Proof.VerifiableTx.DualProof.TargetTxHeader.BlRoot
+				v.Proof.VerifiableTx.DualProof.TargetTxHeader.BlRoot = ptr(hash[:len(hash)-1])
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := loadVectors(t)[1]
+			tt.garble(&v)
+			_, err := VerifyDocumentProof(&v.Proof, v.DocumentId, v.Trusted)
+			if !errors.Is(err, ErrMalformedProof) || !errors.Is(err, ErrInvalidProof) {
+				t.Fatalf("expected ErrMalformedProof, got %v", err)
+			}
+		})
+	}
+}
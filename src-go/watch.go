@@ -0,0 +1,170 @@
+package server
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+)
+
+// watchBuffer is how many transactions a watcher may fall behind before it is dropped
+const watchBuffer = 256
+
+// transactionHub fans out new transactions to the watchers. The transactions written by this instance are pushed
+// right away, the ones written by other instances are found by polling the storage for the transactions written
+// since the previous poll. The polls follow the order the transactions are written in, not their timestamps,
+// so the transactions recorded with an earlier time, e.g. the imported ones, are found as well.
+// The transactions are told apart by their ids, so every one reaches a watcher once.
+type transactionHub struct {
+	storage  Storage
+	interval time.Duration
+
+	mu       sync.Mutex
+	watchers map[*watcher]struct{}
+	// seen are the ids of the transactions published while polling with the number of the last poll that returned
+	// them or was running when they were published. A poll may return the transactions of the previous polls again,
+	// the ones it didn't return won't be returned anymore and are dropped after it
+	seen map[string]uint64
+	// polls is the number of the polls started
+	polls uint64
+	// stopPolling stops the polling, it is nil while nobody watches
+	stopPolling context.CancelFunc
+}
+
+// watcher receives the new transactions of the account, or of all the accounts if the number is empty.
+// The channel is closed if the watcher falls behind
+type watcher struct {
+	accountNumber string
+	transactions  chan TransactionRecord
+}
+
+// newTransactionHub creates the hub, the storage is polled every `interval` while anybody watches,
+// 0 disables the polling, then only the transactions written by this instance are published
+func newTransactionHub(storage Storage, interval time.Duration) *transactionHub {
+	return &transactionHub{
+		storage:  storage,
+		interval: interval,
+		watchers: map[*watcher]struct{}{},
+		seen:     map[string]uint64{},
+	}
+}
+
+func (h *transactionHub) subscribe(accountNumber string) *watcher {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	w := &watcher{accountNumber: accountNumber, transactions: make(chan TransactionRecord, watchBuffer)}
+	h.watchers[w] = struct{}{}
+	if h.stopPolling == nil && h.interval > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		h.stopPolling = cancel
+		go h.poll(ctx)
+	}
+	return w
+}
+
+func (h *transactionHub) unsubscribe(w *watcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delete(h.watchers, w)
+	if len(h.watchers) == 0 && h.stopPolling != nil {
+		h.stopPolling()
+		h.stopPolling = nil
+		h.seen = map[string]uint64{}
+	}
+}
+
+// publish sends the transactions to the watchers of their accounts, the transactions published before are skipped
+func (h *transactionHub) publish(transactions ...TransactionRecord) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for _, t := range transactions {
+		// without the polling every transaction is published once
+		if h.stopPolling != nil {
+			_, ok := h.seen[t.Id]
+			h.seen[t.Id] = h.polls
+			if ok {
+				continue
+			}
+		}
+		for w := range h.watchers {
+			if w.accountNumber != "" && w.accountNumber != t.AccountNumber {
+				continue
+			}
+			select {
+			case w.transactions <- t:
+			default:
+				// the watcher can't keep up, it is dropped rather than holding up the others
+				close(w.transactions)
+				delete(h.watchers, w)
+			}
+		}
+	}
+}
+
+// poll publishes the transactions written after the polling started every interval until the context is done
+func (h *transactionHub) poll(ctx context.Context) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	cursor, err := h.storage.TransactionsCursor(ctx)
+	started := err == nil
+	if err != nil {
+		log.Printf("failed to start polling for new transactions: %v", err)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !started {
+				if cursor, err = h.storage.TransactionsCursor(ctx); err != nil {
+					log.Printf("failed to start polling for new transactions: %v", err)
+					continue
+				}
+				started = true
+				continue
+			}
+			next, err := h.publishAfter(ctx, cursor)
+			if err != nil {
+				log.Printf("failed to poll for new transactions: %v", err)
+				continue
+			}
+			cursor = next
+		}
+	}
+}
+
+// publishAfter pages through the transactions written after the cursor, publishes them and returns the cursor
+// after the last one
+func (h *transactionHub) publishAfter(ctx context.Context, cursor string) (string, error) {
+	h.mu.Lock()
+	h.polls++
+	poll := h.polls
+	h.mu.Unlock()
+
+	next := cursor
+	for page := 1; ; page++ {
+		transactions, pageCursor, err := h.storage.ListTransactionsAfter(ctx, cursor, transactionsPageSize, page)
+		if err != nil {
+			return "", err
+		}
+		h.publish(transactions...)
+		if len(transactions) > 0 {
+			next = pageCursor
+		}
+		if len(transactions) < transactionsPageSize {
+			break
+		}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for id, seenAt := range h.seen {
+		if seenAt < poll {
+			delete(h.seen, id)
+		}
+	}
+	return next, nil
+}
@@ -0,0 +1,58 @@
+package server
+
+import (
+	"context"
+	"testing"
+)
+
+func TestTransactionHubPoll(t *testing.T) {
+	ctx := context.Background()
+	for _, backend := range testStorages {
+		t.Run(backend.name, func(t *testing.T) {
+			storage := openTestStorage(t, backend)
+			if _, err := storage.AddAccount(ctx, testAccount("1001")); err != nil {
+				t.Fatal(err)
+			}
+			// the hub isn't started, the test polls instead of it
+			hub := newTransactionHub(storage, 0)
+			w := hub.subscribe("")
+			hub.stopPolling = func() {}
+			cursor, err := storage.TransactionsCursor(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// the transactions written by another instance are published once, whatever their time
+			var written []string
+			for _, createdAt := range []string{"2001-01-01T00:00:00.000000Z", ""} {
+				transaction := testTransaction("1001", DepositType, 1)
+				transaction.CreatedAt = createdAt
+				id, err := storage.AddTransaction(ctx, transaction)
+				if err != nil {
+					t.Fatal(err)
+				}
+				written = append(written, id)
+				for i := 0; i < 2; i++ {
+					if cursor, err = hub.publishAfter(ctx, cursor); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			for _, id := range written {
+				select {
+				case transaction := <-w.transactions:
+					if transaction.Id != id {
+						t.Fatalf("published %s, expected %s", transaction.Id, id)
+					}
+				default:
+					t.Fatalf("%s isn't published", id)
+				}
+			}
+			select {
+			case transaction := <-w.transactions:
+				t.Fatalf("%s is published again", transaction.Id)
+			default:
+			}
+		})
+	}
+}
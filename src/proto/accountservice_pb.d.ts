@@ -2,6 +2,9 @@
 // file: proto/accountservice.proto
 
 import * as jspb from "google-protobuf";
+import * as google_protobuf_field_mask_pb from "google-protobuf/google/protobuf/field_mask_pb";
+import * as google_protobuf_struct_pb from "google-protobuf/google/protobuf/struct_pb";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 
 export class Account extends jspb.Message {
   getNumber(): string;
@@ -19,6 +22,18 @@ export class Account extends jspb.Message {
   getId(): string;
   setId(value: string): void;
 
+  getBalance(): number;
+  setBalance(value: number): void;
+
+  getOverdraftLimit(): number;
+  setOverdraftLimit(value: number): void;
+
+  getCurrency(): string;
+  setCurrency(value: string): void;
+
+  getCurrencyExponent(): number;
+  setCurrencyExponent(value: number): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Account.AsObject;
   static toObject(includeInstance: boolean, msg: Account): Account.AsObject;
@@ -36,6 +51,10 @@ export namespace Account {
     address: string,
     iban: string,
     id: string,
+    balance: number,
+    overdraftLimit: number,
+    currency: string,
+    currencyExponent: number,
   }
 }
 
@@ -52,6 +71,37 @@ export class Transaction extends jspb.Message {
   getId(): string;
   setId(value: string): void;
 
+  getCounterpartyAccountNumber(): string;
+  setCounterpartyAccountNumber(value: string): void;
+
+  getTransferId(): string;
+  setTransferId(value: string): void;
+
+  getCurrency(): string;
+  setCurrency(value: string): void;
+
+  getReversedTransactionId(): string;
+  setReversedTransactionId(value: string): void;
+
+  getReversalId(): string;
+  setReversalId(value: string): void;
+
+  hasCreatedAt(): boolean;
+  clearCreatedAt(): void;
+  getCreatedAt(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setCreatedAt(value?: google_protobuf_timestamp_pb.Timestamp): void;
+
+  getValueDate(): string;
+  setValueDate(value: string): void;
+
+  getDescription(): string;
+  setDescription(value: string): void;
+
+  getReference(): string;
+  setReference(value: string): void;
+
+  getMetadataMap(): jspb.Map<string, string>;
+  clearMetadataMap(): void;
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): Transaction.AsObject;
   static toObject(includeInstance: boolean, msg: Transaction): Transaction.AsObject;
@@ -68,6 +118,70 @@ export namespace Transaction {
     amount: number,
     type: TransactionTypeMap[keyof TransactionTypeMap],
     id: string,
+    counterpartyAccountNumber: string,
+    transferId: string,
+    currency: string,
+    reversedTransactionId: string,
+    reversalId: string,
+    createdAt?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    valueDate: string,
+    description: string,
+    reference: string,
+    metadataMap: Array<[string, string]>,
+  }
+}
+
+export class FieldFilter extends jspb.Message {
+  getField(): string;
+  setField(value: string): void;
+
+  getOperator(): FilterOperatorMap[keyof FilterOperatorMap];
+  setOperator(value: FilterOperatorMap[keyof FilterOperatorMap]): void;
+
+  hasValue(): boolean;
+  clearValue(): void;
+  getValue(): google_protobuf_struct_pb.Value | undefined;
+  setValue(value?: google_protobuf_struct_pb.Value): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FieldFilter.AsObject;
+  static toObject(includeInstance: boolean, msg: FieldFilter): FieldFilter.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: FieldFilter, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FieldFilter;
+  static deserializeBinaryFromReader(message: FieldFilter, reader: jspb.BinaryReader): FieldFilter;
+}
+
+export namespace FieldFilter {
+  export type AsObject = {
+    field: string,
+    operator: FilterOperatorMap[keyof FilterOperatorMap],
+    value?: google_protobuf_struct_pb.Value.AsObject,
+  }
+}
+
+export class SortField extends jspb.Message {
+  getField(): string;
+  setField(value: string): void;
+
+  getDesc(): boolean;
+  setDesc(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): SortField.AsObject;
+  static toObject(includeInstance: boolean, msg: SortField): SortField.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: SortField, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): SortField;
+  static deserializeBinaryFromReader(message: SortField, reader: jspb.BinaryReader): SortField;
+}
+
+export namespace SortField {
+  export type AsObject = {
+    field: string,
+    desc: boolean,
   }
 }
 
@@ -78,6 +192,19 @@ export class ListAccountsRequest extends jspb.Message {
   getPageNumber(): number;
   setPageNumber(value: number): void;
 
+  clearFiltersList(): void;
+  getFiltersList(): Array<FieldFilter>;
+  setFiltersList(value: Array<FieldFilter>): void;
+  addFilters(value?: FieldFilter, index?: number): FieldFilter;
+
+  clearOrderByList(): void;
+  getOrderByList(): Array<SortField>;
+  setOrderByList(value: Array<SortField>): void;
+  addOrderBy(value?: SortField, index?: number): SortField;
+
+  getPageToken(): string;
+  setPageToken(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ListAccountsRequest.AsObject;
   static toObject(includeInstance: boolean, msg: ListAccountsRequest): ListAccountsRequest.AsObject;
@@ -92,6 +219,9 @@ export namespace ListAccountsRequest {
   export type AsObject = {
     pageSize: number,
     pageNumber: number,
+    filtersList: Array<FieldFilter.AsObject>,
+    orderByList: Array<SortField.AsObject>,
+    pageToken: string,
   }
 }
 
@@ -110,6 +240,9 @@ export class ListAccountsResponse extends jspb.Message {
   setAccountsList(value: Array<Account>): void;
   addAccounts(value?: Account, index?: number): Account;
 
+  getNextPageToken(): string;
+  setNextPageToken(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ListAccountsResponse.AsObject;
   static toObject(includeInstance: boolean, msg: ListAccountsResponse): ListAccountsResponse.AsObject;
@@ -126,6 +259,71 @@ export namespace ListAccountsResponse {
     pageNumber: number,
     totalCount: number,
     accountsList: Array<Account.AsObject>,
+    nextPageToken: string,
+  }
+}
+
+export class GetAccountRequest extends jspb.Message {
+  hasId(): boolean;
+  clearId(): void;
+  getId(): string;
+  setId(value: string): void;
+
+  hasNumber(): boolean;
+  clearNumber(): void;
+  getNumber(): string;
+  setNumber(value: string): void;
+
+  hasIban(): boolean;
+  clearIban(): void;
+  getIban(): string;
+  setIban(value: string): void;
+
+  getSelectorCase(): GetAccountRequest.SelectorCase;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAccountRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAccountRequest): GetAccountRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetAccountRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAccountRequest;
+  static deserializeBinaryFromReader(message: GetAccountRequest, reader: jspb.BinaryReader): GetAccountRequest;
+}
+
+export namespace GetAccountRequest {
+  export type AsObject = {
+    id: string,
+    number: string,
+    iban: string,
+  }
+
+  export enum SelectorCase {
+    SELECTOR_NOT_SET = 0,
+    ID = 1,
+    NUMBER = 2,
+    IBAN = 3,
+  }
+}
+
+export class GetAccountResponse extends jspb.Message {
+  hasAccount(): boolean;
+  clearAccount(): void;
+  getAccount(): Account | undefined;
+  setAccount(value?: Account): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAccountResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAccountResponse): GetAccountResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetAccountResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAccountResponse;
+  static deserializeBinaryFromReader(message: GetAccountResponse, reader: jspb.BinaryReader): GetAccountResponse;
+}
+
+export namespace GetAccountResponse {
+  export type AsObject = {
+    account?: Account.AsObject,
   }
 }
 
@@ -139,6 +337,19 @@ export class ListTransactionsRequest extends jspb.Message {
   getAccountNumber(): string;
   setAccountNumber(value: string): void;
 
+  clearFiltersList(): void;
+  getFiltersList(): Array<FieldFilter>;
+  setFiltersList(value: Array<FieldFilter>): void;
+  addFilters(value?: FieldFilter, index?: number): FieldFilter;
+
+  clearOrderByList(): void;
+  getOrderByList(): Array<SortField>;
+  setOrderByList(value: Array<SortField>): void;
+  addOrderBy(value?: SortField, index?: number): SortField;
+
+  getPageToken(): string;
+  setPageToken(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ListTransactionsRequest.AsObject;
   static toObject(includeInstance: boolean, msg: ListTransactionsRequest): ListTransactionsRequest.AsObject;
@@ -154,6 +365,9 @@ export namespace ListTransactionsRequest {
     pageSize: number,
     pageNumber: number,
     accountNumber: string,
+    filtersList: Array<FieldFilter.AsObject>,
+    orderByList: Array<SortField.AsObject>,
+    pageToken: string,
   }
 }
 
@@ -172,6 +386,9 @@ export class ListTransactionsResponse extends jspb.Message {
   setTransactionsList(value: Array<Transaction>): void;
   addTransactions(value?: Transaction, index?: number): Transaction;
 
+  getNextPageToken(): string;
+  setNextPageToken(value: string): void;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ListTransactionsResponse.AsObject;
   static toObject(includeInstance: boolean, msg: ListTransactionsResponse): ListTransactionsResponse.AsObject;
@@ -188,6 +405,135 @@ export namespace ListTransactionsResponse {
     pageNumber: number,
     totalCount: number,
     transactionsList: Array<Transaction.AsObject>,
+    nextPageToken: string,
+  }
+}
+
+export class ExportTransactionsRequest extends jspb.Message {
+  getAccountNumber(): string;
+  setAccountNumber(value: string): void;
+
+  clearFiltersList(): void;
+  getFiltersList(): Array<FieldFilter>;
+  setFiltersList(value: Array<FieldFilter>): void;
+  addFilters(value?: FieldFilter, index?: number): FieldFilter;
+
+  clearOrderByList(): void;
+  getOrderByList(): Array<SortField>;
+  setOrderByList(value: Array<SortField>): void;
+  addOrderBy(value?: SortField, index?: number): SortField;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExportTransactionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportTransactionsRequest): ExportTransactionsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ExportTransactionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportTransactionsRequest;
+  static deserializeBinaryFromReader(message: ExportTransactionsRequest, reader: jspb.BinaryReader): ExportTransactionsRequest;
+}
+
+export namespace ExportTransactionsRequest {
+  export type AsObject = {
+    accountNumber: string,
+    filtersList: Array<FieldFilter.AsObject>,
+    orderByList: Array<SortField.AsObject>,
+  }
+}
+
+export class CreateTransactionsRequest extends jspb.Message {
+  clearTransactionsList(): void;
+  getTransactionsList(): Array<Transaction>;
+  setTransactionsList(value: Array<Transaction>): void;
+  addTransactions(value?: Transaction, index?: number): Transaction;
+
+  getAllOrNothing(): boolean;
+  setAllOrNothing(value: boolean): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateTransactionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateTransactionsRequest): CreateTransactionsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateTransactionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateTransactionsRequest;
+  static deserializeBinaryFromReader(message: CreateTransactionsRequest, reader: jspb.BinaryReader): CreateTransactionsRequest;
+}
+
+export namespace CreateTransactionsRequest {
+  export type AsObject = {
+    transactionsList: Array<Transaction.AsObject>,
+    allOrNothing: boolean,
+  }
+}
+
+export class CreateTransactionsResult extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getCode(): number;
+  setCode(value: number): void;
+
+  getError(): string;
+  setError(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateTransactionsResult.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateTransactionsResult): CreateTransactionsResult.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateTransactionsResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateTransactionsResult;
+  static deserializeBinaryFromReader(message: CreateTransactionsResult, reader: jspb.BinaryReader): CreateTransactionsResult;
+}
+
+export namespace CreateTransactionsResult {
+  export type AsObject = {
+    id: string,
+    code: number,
+    error: string,
+  }
+}
+
+export class CreateTransactionsResponse extends jspb.Message {
+  clearResultsList(): void;
+  getResultsList(): Array<CreateTransactionsResult>;
+  setResultsList(value: Array<CreateTransactionsResult>): void;
+  addResults(value?: CreateTransactionsResult, index?: number): CreateTransactionsResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CreateTransactionsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: CreateTransactionsResponse): CreateTransactionsResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: CreateTransactionsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CreateTransactionsResponse;
+  static deserializeBinaryFromReader(message: CreateTransactionsResponse, reader: jspb.BinaryReader): CreateTransactionsResponse;
+}
+
+export namespace CreateTransactionsResponse {
+  export type AsObject = {
+    resultsList: Array<CreateTransactionsResult.AsObject>,
+  }
+}
+
+export class WatchTransactionsRequest extends jspb.Message {
+  getAccountNumber(): string;
+  setAccountNumber(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): WatchTransactionsRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: WatchTransactionsRequest): WatchTransactionsRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: WatchTransactionsRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): WatchTransactionsRequest;
+  static deserializeBinaryFromReader(message: WatchTransactionsRequest, reader: jspb.BinaryReader): WatchTransactionsRequest;
+}
+
+export namespace WatchTransactionsRequest {
+  export type AsObject = {
+    accountNumber: string,
   }
 }
 
@@ -211,6 +557,182 @@ export namespace CreateAccountResponse {
   }
 }
 
+export class UpdateAccountRequest extends jspb.Message {
+  getNumber(): string;
+  setNumber(value: string): void;
+
+  getName(): string;
+  setName(value: string): void;
+
+  getAddress(): string;
+  setAddress(value: string): void;
+
+  getIban(): string;
+  setIban(value: string): void;
+
+  hasUpdateMask(): boolean;
+  clearUpdateMask(): void;
+  getUpdateMask(): google_protobuf_field_mask_pb.FieldMask | undefined;
+  setUpdateMask(value?: google_protobuf_field_mask_pb.FieldMask): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateAccountRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateAccountRequest): UpdateAccountRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UpdateAccountRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateAccountRequest;
+  static deserializeBinaryFromReader(message: UpdateAccountRequest, reader: jspb.BinaryReader): UpdateAccountRequest;
+}
+
+export namespace UpdateAccountRequest {
+  export type AsObject = {
+    number: string,
+    name: string,
+    address: string,
+    iban: string,
+    updateMask?: google_protobuf_field_mask_pb.FieldMask.AsObject,
+  }
+}
+
+export class UpdateAccountResponse extends jspb.Message {
+  hasAccount(): boolean;
+  clearAccount(): void;
+  getAccount(): Account | undefined;
+  setAccount(value?: Account): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): UpdateAccountResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: UpdateAccountResponse): UpdateAccountResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: UpdateAccountResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): UpdateAccountResponse;
+  static deserializeBinaryFromReader(message: UpdateAccountResponse, reader: jspb.BinaryReader): UpdateAccountResponse;
+}
+
+export namespace UpdateAccountResponse {
+  export type AsObject = {
+    account?: Account.AsObject,
+  }
+}
+
+export class GetAccountHistoryRequest extends jspb.Message {
+  getAccountNumber(): string;
+  setAccountNumber(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAccountHistoryRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAccountHistoryRequest): GetAccountHistoryRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetAccountHistoryRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAccountHistoryRequest;
+  static deserializeBinaryFromReader(message: GetAccountHistoryRequest, reader: jspb.BinaryReader): GetAccountHistoryRequest;
+}
+
+export namespace GetAccountHistoryRequest {
+  export type AsObject = {
+    accountNumber: string,
+  }
+}
+
+export class AccountRevision extends jspb.Message {
+  getRevision(): string;
+  setRevision(value: string): void;
+
+  getTransactionId(): string;
+  setTransactionId(value: string): void;
+
+  hasAccount(): boolean;
+  clearAccount(): void;
+  getAccount(): Account | undefined;
+  setAccount(value?: Account): void;
+
+  getCreator(): string;
+  setCreator(value: string): void;
+
+  getTs(): number;
+  setTs(value: number): void;
+
+  getActor(): string;
+  setActor(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AccountRevision.AsObject;
+  static toObject(includeInstance: boolean, msg: AccountRevision): AccountRevision.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AccountRevision, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AccountRevision;
+  static deserializeBinaryFromReader(message: AccountRevision, reader: jspb.BinaryReader): AccountRevision;
+}
+
+export namespace AccountRevision {
+  export type AsObject = {
+    revision: string,
+    transactionId: string,
+    account?: Account.AsObject,
+    creator: string,
+    ts: number,
+    actor: string,
+  }
+}
+
+export class AccountDiff extends jspb.Message {
+  getDiffIds(): string;
+  setDiffIds(value: string): void;
+
+  hasDiff(): boolean;
+  clearDiff(): void;
+  getDiff(): google_protobuf_struct_pb.Struct | undefined;
+  setDiff(value?: google_protobuf_struct_pb.Struct): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AccountDiff.AsObject;
+  static toObject(includeInstance: boolean, msg: AccountDiff): AccountDiff.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: AccountDiff, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AccountDiff;
+  static deserializeBinaryFromReader(message: AccountDiff, reader: jspb.BinaryReader): AccountDiff;
+}
+
+export namespace AccountDiff {
+  export type AsObject = {
+    diffIds: string,
+    diff?: google_protobuf_struct_pb.Struct.AsObject,
+  }
+}
+
+export class GetAccountHistoryResponse extends jspb.Message {
+  clearRevisionsList(): void;
+  getRevisionsList(): Array<AccountRevision>;
+  setRevisionsList(value: Array<AccountRevision>): void;
+  addRevisions(value?: AccountRevision, index?: number): AccountRevision;
+
+  clearDiffsList(): void;
+  getDiffsList(): Array<AccountDiff>;
+  setDiffsList(value: Array<AccountDiff>): void;
+  addDiffs(value?: AccountDiff, index?: number): AccountDiff;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAccountHistoryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAccountHistoryResponse): GetAccountHistoryResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetAccountHistoryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAccountHistoryResponse;
+  static deserializeBinaryFromReader(message: GetAccountHistoryResponse, reader: jspb.BinaryReader): GetAccountHistoryResponse;
+}
+
+export namespace GetAccountHistoryResponse {
+  export type AsObject = {
+    revisionsList: Array<AccountRevision.AsObject>,
+    diffsList: Array<AccountDiff.AsObject>,
+  }
+}
+
 export class CreateTransactionResponse extends jspb.Message {
   getId(): string;
   setId(value: string): void;
@@ -231,6 +753,441 @@ export namespace CreateTransactionResponse {
   }
 }
 
+export class GetAccountBalanceRequest extends jspb.Message {
+  getAccountNumber(): string;
+  setAccountNumber(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAccountBalanceRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAccountBalanceRequest): GetAccountBalanceRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetAccountBalanceRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAccountBalanceRequest;
+  static deserializeBinaryFromReader(message: GetAccountBalanceRequest, reader: jspb.BinaryReader): GetAccountBalanceRequest;
+}
+
+export namespace GetAccountBalanceRequest {
+  export type AsObject = {
+    accountNumber: string,
+  }
+}
+
+export class GetAccountBalanceResponse extends jspb.Message {
+  getAccountNumber(): string;
+  setAccountNumber(value: string): void;
+
+  getBalance(): number;
+  setBalance(value: number): void;
+
+  getCurrency(): string;
+  setCurrency(value: string): void;
+
+  getCurrencyExponent(): number;
+  setCurrencyExponent(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetAccountBalanceResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetAccountBalanceResponse): GetAccountBalanceResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetAccountBalanceResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetAccountBalanceResponse;
+  static deserializeBinaryFromReader(message: GetAccountBalanceResponse, reader: jspb.BinaryReader): GetAccountBalanceResponse;
+}
+
+export namespace GetAccountBalanceResponse {
+  export type AsObject = {
+    accountNumber: string,
+    balance: number,
+    currency: string,
+    currencyExponent: number,
+  }
+}
+
+export class GetStatementRequest extends jspb.Message {
+  getAccountNumber(): string;
+  setAccountNumber(value: string): void;
+
+  getFromDate(): string;
+  setFromDate(value: string): void;
+
+  getToDate(): string;
+  setToDate(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStatementRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStatementRequest): GetStatementRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetStatementRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStatementRequest;
+  static deserializeBinaryFromReader(message: GetStatementRequest, reader: jspb.BinaryReader): GetStatementRequest;
+}
+
+export namespace GetStatementRequest {
+  export type AsObject = {
+    accountNumber: string,
+    fromDate: string,
+    toDate: string,
+  }
+}
+
+export class StatementLine extends jspb.Message {
+  hasTransaction(): boolean;
+  clearTransaction(): void;
+  getTransaction(): Transaction | undefined;
+  setTransaction(value?: Transaction): void;
+
+  getBalance(): number;
+  setBalance(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StatementLine.AsObject;
+  static toObject(includeInstance: boolean, msg: StatementLine): StatementLine.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: StatementLine, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StatementLine;
+  static deserializeBinaryFromReader(message: StatementLine, reader: jspb.BinaryReader): StatementLine;
+}
+
+export namespace StatementLine {
+  export type AsObject = {
+    transaction?: Transaction.AsObject,
+    balance: number,
+  }
+}
+
+export class GetStatementResponse extends jspb.Message {
+  getAccountNumber(): string;
+  setAccountNumber(value: string): void;
+
+  getCurrency(): string;
+  setCurrency(value: string): void;
+
+  getCurrencyExponent(): number;
+  setCurrencyExponent(value: number): void;
+
+  getFromDate(): string;
+  setFromDate(value: string): void;
+
+  getToDate(): string;
+  setToDate(value: string): void;
+
+  getOpeningBalance(): number;
+  setOpeningBalance(value: number): void;
+
+  clearLinesList(): void;
+  getLinesList(): Array<StatementLine>;
+  setLinesList(value: Array<StatementLine>): void;
+  addLines(value?: StatementLine, index?: number): StatementLine;
+
+  getTotalDeposits(): number;
+  setTotalDeposits(value: number): void;
+
+  getTotalWithdrawals(): number;
+  setTotalWithdrawals(value: number): void;
+
+  getClosingBalance(): number;
+  setClosingBalance(value: number): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStatementResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStatementResponse): GetStatementResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetStatementResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStatementResponse;
+  static deserializeBinaryFromReader(message: GetStatementResponse, reader: jspb.BinaryReader): GetStatementResponse;
+}
+
+export namespace GetStatementResponse {
+  export type AsObject = {
+    accountNumber: string,
+    currency: string,
+    currencyExponent: number,
+    fromDate: string,
+    toDate: string,
+    openingBalance: number,
+    linesList: Array<StatementLine.AsObject>,
+    totalDeposits: number,
+    totalWithdrawals: number,
+    closingBalance: number,
+  }
+}
+
+export class ExportStatementRequest extends jspb.Message {
+  getAccountNumber(): string;
+  setAccountNumber(value: string): void;
+
+  getFromDate(): string;
+  setFromDate(value: string): void;
+
+  getToDate(): string;
+  setToDate(value: string): void;
+
+  getFormat(): StatementFormatMap[keyof StatementFormatMap];
+  setFormat(value: StatementFormatMap[keyof StatementFormatMap]): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExportStatementRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportStatementRequest): ExportStatementRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ExportStatementRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportStatementRequest;
+  static deserializeBinaryFromReader(message: ExportStatementRequest, reader: jspb.BinaryReader): ExportStatementRequest;
+}
+
+export namespace ExportStatementRequest {
+  export type AsObject = {
+    accountNumber: string,
+    fromDate: string,
+    toDate: string,
+    format: StatementFormatMap[keyof StatementFormatMap],
+  }
+}
+
+export class ExportStatementResponse extends jspb.Message {
+  getContent(): Uint8Array | string;
+  getContent_asU8(): Uint8Array;
+  getContent_asB64(): string;
+  setContent(value: Uint8Array | string): void;
+
+  getContentType(): string;
+  setContentType(value: string): void;
+
+  getFileName(): string;
+  setFileName(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ExportStatementResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ExportStatementResponse): ExportStatementResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ExportStatementResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ExportStatementResponse;
+  static deserializeBinaryFromReader(message: ExportStatementResponse, reader: jspb.BinaryReader): ExportStatementResponse;
+}
+
+export namespace ExportStatementResponse {
+  export type AsObject = {
+    content: Uint8Array | string,
+    contentType: string,
+    fileName: string,
+  }
+}
+
+export class TransferRequest extends jspb.Message {
+  getFromAccountNumber(): string;
+  setFromAccountNumber(value: string): void;
+
+  getToAccountNumber(): string;
+  setToAccountNumber(value: string): void;
+
+  getAmount(): number;
+  setAmount(value: number): void;
+
+  getCurrency(): string;
+  setCurrency(value: string): void;
+
+  getValueDate(): string;
+  setValueDate(value: string): void;
+
+  getDescription(): string;
+  setDescription(value: string): void;
+
+  getReference(): string;
+  setReference(value: string): void;
+
+  getMetadataMap(): jspb.Map<string, string>;
+  clearMetadataMap(): void;
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TransferRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: TransferRequest): TransferRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TransferRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TransferRequest;
+  static deserializeBinaryFromReader(message: TransferRequest, reader: jspb.BinaryReader): TransferRequest;
+}
+
+export namespace TransferRequest {
+  export type AsObject = {
+    fromAccountNumber: string,
+    toAccountNumber: string,
+    amount: number,
+    currency: string,
+    valueDate: string,
+    description: string,
+    reference: string,
+    metadataMap: Array<[string, string]>,
+  }
+}
+
+export class TransferResponse extends jspb.Message {
+  getTransferId(): string;
+  setTransferId(value: string): void;
+
+  getWithdrawalId(): string;
+  setWithdrawalId(value: string): void;
+
+  getDepositId(): string;
+  setDepositId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): TransferResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: TransferResponse): TransferResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: TransferResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): TransferResponse;
+  static deserializeBinaryFromReader(message: TransferResponse, reader: jspb.BinaryReader): TransferResponse;
+}
+
+export namespace TransferResponse {
+  export type AsObject = {
+    transferId: string,
+    withdrawalId: string,
+    depositId: string,
+  }
+}
+
+export class ReverseTransactionRequest extends jspb.Message {
+  getTransactionId(): string;
+  setTransactionId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ReverseTransactionRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: ReverseTransactionRequest): ReverseTransactionRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ReverseTransactionRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReverseTransactionRequest;
+  static deserializeBinaryFromReader(message: ReverseTransactionRequest, reader: jspb.BinaryReader): ReverseTransactionRequest;
+}
+
+export namespace ReverseTransactionRequest {
+  export type AsObject = {
+    transactionId: string,
+  }
+}
+
+export class ReverseTransactionResponse extends jspb.Message {
+  getId(): string;
+  setId(value: string): void;
+
+  getTransferId(): string;
+  setTransferId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ReverseTransactionResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ReverseTransactionResponse): ReverseTransactionResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: ReverseTransactionResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ReverseTransactionResponse;
+  static deserializeBinaryFromReader(message: ReverseTransactionResponse, reader: jspb.BinaryReader): ReverseTransactionResponse;
+}
+
+export namespace ReverseTransactionResponse {
+  export type AsObject = {
+    id: string,
+    transferId: string,
+  }
+}
+
+export class GetTransactionProofRequest extends jspb.Message {
+  getTransactionId(): string;
+  setTransactionId(value: string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetTransactionProofRequest.AsObject;
+  static toObject(includeInstance: boolean, msg: GetTransactionProofRequest): GetTransactionProofRequest.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetTransactionProofRequest, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetTransactionProofRequest;
+  static deserializeBinaryFromReader(message: GetTransactionProofRequest, reader: jspb.BinaryReader): GetTransactionProofRequest;
+}
+
+export namespace GetTransactionProofRequest {
+  export type AsObject = {
+    transactionId: string,
+  }
+}
+
+export class LedgerState extends jspb.Message {
+  getDb(): string;
+  setDb(value: string): void;
+
+  getTxId(): number;
+  setTxId(value: number): void;
+
+  getTxHash(): Uint8Array | string;
+  getTxHash_asU8(): Uint8Array;
+  getTxHash_asB64(): string;
+  setTxHash(value: Uint8Array | string): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): LedgerState.AsObject;
+  static toObject(includeInstance: boolean, msg: LedgerState): LedgerState.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: LedgerState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): LedgerState;
+  static deserializeBinaryFromReader(message: LedgerState, reader: jspb.BinaryReader): LedgerState;
+}
+
+export namespace LedgerState {
+  export type AsObject = {
+    db: string,
+    txId: number,
+    txHash: Uint8Array | string,
+  }
+}
+
+export class GetTransactionProofResponse extends jspb.Message {
+  hasTransaction(): boolean;
+  clearTransaction(): void;
+  getTransaction(): Transaction | undefined;
+  setTransaction(value?: Transaction): void;
+
+  getDocumentProof(): Uint8Array | string;
+  getDocumentProof_asU8(): Uint8Array;
+  getDocumentProof_asB64(): string;
+  setDocumentProof(value: Uint8Array | string): void;
+
+  hasTrustedState(): boolean;
+  clearTrustedState(): void;
+  getTrustedState(): LedgerState | undefined;
+  setTrustedState(value?: LedgerState): void;
+
+  hasState(): boolean;
+  clearState(): void;
+  getState(): LedgerState | undefined;
+  setState(value?: LedgerState): void;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetTransactionProofResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetTransactionProofResponse): GetTransactionProofResponse.AsObject;
+  static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+  static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+  static serializeBinaryToWriter(message: GetTransactionProofResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetTransactionProofResponse;
+  static deserializeBinaryFromReader(message: GetTransactionProofResponse, reader: jspb.BinaryReader): GetTransactionProofResponse;
+}
+
+export namespace GetTransactionProofResponse {
+  export type AsObject = {
+    transaction?: Transaction.AsObject,
+    documentProof: Uint8Array | string,
+    trustedState?: LedgerState.AsObject,
+    state?: LedgerState.AsObject,
+  }
+}
+
 export interface TransactionTypeMap {
   DEPOSIT: 0;
   WITHDRAWAL: 1;
@@ -238,3 +1195,22 @@ export interface TransactionTypeMap {
 
 export const TransactionType: TransactionTypeMap;
 
+export interface FilterOperatorMap {
+  EQ: 0;
+  NE: 1;
+  LT: 2;
+  LE: 3;
+  GT: 4;
+  GE: 5;
+  LIKE: 6;
+}
+
+export const FilterOperator: FilterOperatorMap;
+
+export interface StatementFormatMap {
+  CAMT_053: 0;
+  MT940: 1;
+}
+
+export const StatementFormat: StatementFormatMap;
+
@@ -21,15 +21,53 @@ var global =
     (function () { return this; }).call(null) ||
     Function('return this')();
 
+var google_protobuf_field_mask_pb = require('google-protobuf/google/protobuf/field_mask_pb.js');
+goog.object.extend(proto, google_protobuf_field_mask_pb);
+var google_protobuf_struct_pb = require('google-protobuf/google/protobuf/struct_pb.js');
+goog.object.extend(proto, google_protobuf_struct_pb);
+var google_protobuf_timestamp_pb = require('google-protobuf/google/protobuf/timestamp_pb.js');
+goog.object.extend(proto, google_protobuf_timestamp_pb);
 goog.exportSymbol('proto.account_service.Account', null, global);
+goog.exportSymbol('proto.account_service.AccountDiff', null, global);
+goog.exportSymbol('proto.account_service.AccountRevision', null, global);
 goog.exportSymbol('proto.account_service.CreateAccountResponse', null, global);
 goog.exportSymbol('proto.account_service.CreateTransactionResponse', null, global);
+goog.exportSymbol('proto.account_service.CreateTransactionsRequest', null, global);
+goog.exportSymbol('proto.account_service.CreateTransactionsResponse', null, global);
+goog.exportSymbol('proto.account_service.CreateTransactionsResult', null, global);
+goog.exportSymbol('proto.account_service.ExportStatementRequest', null, global);
+goog.exportSymbol('proto.account_service.ExportStatementResponse', null, global);
+goog.exportSymbol('proto.account_service.ExportTransactionsRequest', null, global);
+goog.exportSymbol('proto.account_service.FieldFilter', null, global);
+goog.exportSymbol('proto.account_service.FilterOperator', null, global);
+goog.exportSymbol('proto.account_service.GetAccountBalanceRequest', null, global);
+goog.exportSymbol('proto.account_service.GetAccountBalanceResponse', null, global);
+goog.exportSymbol('proto.account_service.GetAccountHistoryRequest', null, global);
+goog.exportSymbol('proto.account_service.GetAccountHistoryResponse', null, global);
+goog.exportSymbol('proto.account_service.GetAccountRequest', null, global);
+goog.exportSymbol('proto.account_service.GetAccountRequest.SelectorCase', null, global);
+goog.exportSymbol('proto.account_service.GetAccountResponse', null, global);
+goog.exportSymbol('proto.account_service.GetStatementRequest', null, global);
+goog.exportSymbol('proto.account_service.GetStatementResponse', null, global);
+goog.exportSymbol('proto.account_service.GetTransactionProofRequest', null, global);
+goog.exportSymbol('proto.account_service.GetTransactionProofResponse', null, global);
+goog.exportSymbol('proto.account_service.LedgerState', null, global);
 goog.exportSymbol('proto.account_service.ListAccountsRequest', null, global);
 goog.exportSymbol('proto.account_service.ListAccountsResponse', null, global);
 goog.exportSymbol('proto.account_service.ListTransactionsRequest', null, global);
 goog.exportSymbol('proto.account_service.ListTransactionsResponse', null, global);
+goog.exportSymbol('proto.account_service.ReverseTransactionRequest', null, global);
+goog.exportSymbol('proto.account_service.ReverseTransactionResponse', null, global);
+goog.exportSymbol('proto.account_service.SortField', null, global);
+goog.exportSymbol('proto.account_service.StatementFormat', null, global);
+goog.exportSymbol('proto.account_service.StatementLine', null, global);
 goog.exportSymbol('proto.account_service.Transaction', null, global);
 goog.exportSymbol('proto.account_service.TransactionType', null, global);
+goog.exportSymbol('proto.account_service.TransferRequest', null, global);
+goog.exportSymbol('proto.account_service.TransferResponse', null, global);
+goog.exportSymbol('proto.account_service.UpdateAccountRequest', null, global);
+goog.exportSymbol('proto.account_service.UpdateAccountResponse', null, global);
+goog.exportSymbol('proto.account_service.WatchTransactionsRequest', null, global);
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -82,9 +120,51 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.account_service.ListAccountsRequest = function(opt_data) {
+proto.account_service.FieldFilter = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.FieldFilter, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.FieldFilter.displayName = 'proto.account_service.FieldFilter';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.SortField = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.account_service.SortField, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.SortField.displayName = 'proto.account_service.SortField';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.ListAccountsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.account_service.ListAccountsRequest.repeatedFields_, null);
+};
 goog.inherits(proto.account_service.ListAccountsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
@@ -124,9 +204,51 @@ if (goog.DEBUG && !COMPILED) {
  * @extends {jspb.Message}
  * @constructor
  */
-proto.account_service.ListTransactionsRequest = function(opt_data) {
+proto.account_service.GetAccountRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, proto.account_service.GetAccountRequest.oneofGroups_);
+};
+goog.inherits(proto.account_service.GetAccountRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetAccountRequest.displayName = 'proto.account_service.GetAccountRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetAccountResponse = function(opt_data) {
   jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.account_service.GetAccountResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetAccountResponse.displayName = 'proto.account_service.GetAccountResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.ListTransactionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.account_service.ListTransactionsRequest.repeatedFields_, null);
+};
 goog.inherits(proto.account_service.ListTransactionsRequest, jspb.Message);
 if (goog.DEBUG && !COMPILED) {
   /**
@@ -157,47 +279,7266 @@ if (goog.DEBUG && !COMPILED) {
   proto.account_service.ListTransactionsResponse.displayName = 'proto.account_service.ListTransactionsResponse';
 }
 /**
- * Generated by JsPbCodeGenerator.
- * @param {Array=} opt_data Optional initial data array, typically from a
- * server response, or constructed directly in Javascript. The array is used
- * in place and becomes part of the constructed object. It is not cloned.
- * If no data is provided, the constructed object will be empty, but still
- * valid.
- * @extends {jspb.Message}
- * @constructor
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.ExportTransactionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.account_service.ExportTransactionsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.account_service.ExportTransactionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.ExportTransactionsRequest.displayName = 'proto.account_service.ExportTransactionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.CreateTransactionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.account_service.CreateTransactionsRequest.repeatedFields_, null);
+};
+goog.inherits(proto.account_service.CreateTransactionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.CreateTransactionsRequest.displayName = 'proto.account_service.CreateTransactionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.CreateTransactionsResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.CreateTransactionsResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.CreateTransactionsResult.displayName = 'proto.account_service.CreateTransactionsResult';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.CreateTransactionsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.account_service.CreateTransactionsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.account_service.CreateTransactionsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.CreateTransactionsResponse.displayName = 'proto.account_service.CreateTransactionsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.WatchTransactionsRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.WatchTransactionsRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.WatchTransactionsRequest.displayName = 'proto.account_service.WatchTransactionsRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.CreateAccountResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.CreateAccountResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.CreateAccountResponse.displayName = 'proto.account_service.CreateAccountResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.UpdateAccountRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.UpdateAccountRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.UpdateAccountRequest.displayName = 'proto.account_service.UpdateAccountRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.UpdateAccountResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.UpdateAccountResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.UpdateAccountResponse.displayName = 'proto.account_service.UpdateAccountResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetAccountHistoryRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.GetAccountHistoryRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetAccountHistoryRequest.displayName = 'proto.account_service.GetAccountHistoryRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.AccountRevision = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.AccountRevision, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.AccountRevision.displayName = 'proto.account_service.AccountRevision';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.AccountDiff = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.AccountDiff, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.AccountDiff.displayName = 'proto.account_service.AccountDiff';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetAccountHistoryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.account_service.GetAccountHistoryResponse.repeatedFields_, null);
+};
+goog.inherits(proto.account_service.GetAccountHistoryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetAccountHistoryResponse.displayName = 'proto.account_service.GetAccountHistoryResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.CreateTransactionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.CreateTransactionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.CreateTransactionResponse.displayName = 'proto.account_service.CreateTransactionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetAccountBalanceRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.GetAccountBalanceRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetAccountBalanceRequest.displayName = 'proto.account_service.GetAccountBalanceRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetAccountBalanceResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.GetAccountBalanceResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetAccountBalanceResponse.displayName = 'proto.account_service.GetAccountBalanceResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetStatementRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.GetStatementRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetStatementRequest.displayName = 'proto.account_service.GetStatementRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.StatementLine = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.StatementLine, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.StatementLine.displayName = 'proto.account_service.StatementLine';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetStatementResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.account_service.GetStatementResponse.repeatedFields_, null);
+};
+goog.inherits(proto.account_service.GetStatementResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetStatementResponse.displayName = 'proto.account_service.GetStatementResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.ExportStatementRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.ExportStatementRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.ExportStatementRequest.displayName = 'proto.account_service.ExportStatementRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.ExportStatementResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.ExportStatementResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.ExportStatementResponse.displayName = 'proto.account_service.ExportStatementResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.TransferRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.TransferRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.TransferRequest.displayName = 'proto.account_service.TransferRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.TransferResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.TransferResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.TransferResponse.displayName = 'proto.account_service.TransferResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.ReverseTransactionRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.ReverseTransactionRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.ReverseTransactionRequest.displayName = 'proto.account_service.ReverseTransactionRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.ReverseTransactionResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.ReverseTransactionResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.ReverseTransactionResponse.displayName = 'proto.account_service.ReverseTransactionResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetTransactionProofRequest = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.GetTransactionProofRequest, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetTransactionProofRequest.displayName = 'proto.account_service.GetTransactionProofRequest';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.LedgerState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.LedgerState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.LedgerState.displayName = 'proto.account_service.LedgerState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.account_service.GetTransactionProofResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.account_service.GetTransactionProofResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.account_service.GetTransactionProofResponse.displayName = 'proto.account_service.GetTransactionProofResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.Account.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.Account.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.Account} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.Account.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    number: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    address: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    iban: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    id: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    balance: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    overdraftLimit: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    currency: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    currencyExponent: jspb.Message.getFieldWithDefault(msg, 9, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.Account}
+ */
+proto.account_service.Account.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.Account;
+  return proto.account_service.Account.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.Account} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.Account}
+ */
+proto.account_service.Account.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNumber(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIban(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBalance(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setOverdraftLimit(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCurrency(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCurrencyExponent(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.Account.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.Account.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.Account} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.Account.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getIban();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getBalance();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getOverdraftLimit();
+  if (f !== 0) {
+    writer.writeInt64(
+      7,
+      f
+    );
+  }
+  f = message.getCurrency();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getCurrencyExponent();
+  if (f !== 0) {
+    writer.writeInt32(
+      9,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string number = 1;
+ * @return {string}
+ */
+proto.account_service.Account.prototype.getNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.account_service.Account.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string address = 3;
+ * @return {string}
+ */
+proto.account_service.Account.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string iban = 4;
+ * @return {string}
+ */
+proto.account_service.Account.prototype.getIban = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setIban = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string id = 5;
+ * @return {string}
+ */
+proto.account_service.Account.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional int64 balance = 6;
+ * @return {number}
+ */
+proto.account_service.Account.prototype.getBalance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setBalance = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional int64 overdraft_limit = 7;
+ * @return {number}
+ */
+proto.account_service.Account.prototype.getOverdraftLimit = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setOverdraftLimit = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional string currency = 8;
+ * @return {string}
+ */
+proto.account_service.Account.prototype.getCurrency = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setCurrency = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional int32 currency_exponent = 9;
+ * @return {number}
+ */
+proto.account_service.Account.prototype.getCurrencyExponent = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.Account} returns this
+ */
+proto.account_service.Account.prototype.setCurrencyExponent = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.Transaction.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.Transaction.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.Transaction} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.Transaction.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    amount: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    type: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    id: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    counterpartyAccountNumber: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    transferId: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    currency: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    reversedTransactionId: jspb.Message.getFieldWithDefault(msg, 8, ""),
+    reversalId: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    createdAt: (f = msg.getCreatedAt()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    valueDate: jspb.Message.getFieldWithDefault(msg, 11, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 12, ""),
+    reference: jspb.Message.getFieldWithDefault(msg, 13, ""),
+    metadataMap: (f = msg.getMetadataMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.Transaction}
+ */
+proto.account_service.Transaction.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.Transaction;
+  return proto.account_service.Transaction.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.Transaction} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.Transaction}
+ */
+proto.account_service.Transaction.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAmount(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.account_service.TransactionType} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCounterpartyAccountNumber(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTransferId(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCurrency(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReversedTransactionId(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReversalId(value);
+      break;
+    case 10:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setCreatedAt(value);
+      break;
+    case 11:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValueDate(value);
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 13:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReference(value);
+      break;
+    case 14:
+      var value = msg.getMetadataMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.Transaction.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.Transaction.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.Transaction} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.Transaction.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getAmount();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getCounterpartyAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getTransferId();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getCurrency();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getReversedTransactionId();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+  f = message.getReversalId();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getValueDate();
+  if (f.length > 0) {
+    writer.writeString(
+      11,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      12,
+      f
+    );
+  }
+  f = message.getReference();
+  if (f.length > 0) {
+    writer.writeString(
+      13,
+      f
+    );
+  }
+  f = message.getMetadataMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(14, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 amount = 2;
+ * @return {number}
+ */
+proto.account_service.Transaction.prototype.getAmount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setAmount = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional TransactionType type = 3;
+ * @return {!proto.account_service.TransactionType}
+ */
+proto.account_service.Transaction.prototype.getType = function() {
+  return /** @type {!proto.account_service.TransactionType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.account_service.TransactionType} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional string id = 4;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string counterparty_account_number = 5;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getCounterpartyAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setCounterpartyAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string transfer_id = 6;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getTransferId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setTransferId = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string currency = 7;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getCurrency = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setCurrency = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional string reversed_transaction_id = 8;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getReversedTransactionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setReversedTransactionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+
+/**
+ * optional string reversal_id = 9;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getReversalId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setReversalId = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional google.protobuf.Timestamp created_at = 10;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.account_service.Transaction.prototype.getCreatedAt = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 10));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.account_service.Transaction} returns this
+*/
+proto.account_service.Transaction.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.clearCreatedAt = function() {
+  return this.setCreatedAt(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.Transaction.prototype.hasCreatedAt = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional string value_date = 11;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getValueDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 11, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setValueDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 11, value);
+};
+
+
+/**
+ * optional string description = 12;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 12, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 12, value);
+};
+
+
+/**
+ * optional string reference = 13;
+ * @return {string}
+ */
+proto.account_service.Transaction.prototype.getReference = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 13, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.setReference = function(value) {
+  return jspb.Message.setProto3StringField(this, 13, value);
+};
+
+
+/**
+ * map<string, string> metadata = 14;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.account_service.Transaction.prototype.getMetadataMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 14, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.account_service.Transaction} returns this
+ */
+proto.account_service.Transaction.prototype.clearMetadataMap = function() {
+  this.getMetadataMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.FieldFilter.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.FieldFilter.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.FieldFilter} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.FieldFilter.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    field: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    operator: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    value: (f = msg.getValue()) && google_protobuf_struct_pb.Value.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.FieldFilter}
+ */
+proto.account_service.FieldFilter.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.FieldFilter;
+  return proto.account_service.FieldFilter.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.FieldFilter} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.FieldFilter}
+ */
+proto.account_service.FieldFilter.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.account_service.FilterOperator} */ (reader.readEnum());
+      msg.setOperator(value);
+      break;
+    case 3:
+      var value = new google_protobuf_struct_pb.Value;
+      reader.readMessage(value,google_protobuf_struct_pb.Value.deserializeBinaryFromReader);
+      msg.setValue(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.FieldFilter.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.FieldFilter.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.FieldFilter} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.FieldFilter.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOperator();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      google_protobuf_struct_pb.Value.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string field = 1;
+ * @return {string}
+ */
+proto.account_service.FieldFilter.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.FieldFilter} returns this
+ */
+proto.account_service.FieldFilter.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional FilterOperator operator = 2;
+ * @return {!proto.account_service.FilterOperator}
+ */
+proto.account_service.FieldFilter.prototype.getOperator = function() {
+  return /** @type {!proto.account_service.FilterOperator} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.account_service.FilterOperator} value
+ * @return {!proto.account_service.FieldFilter} returns this
+ */
+proto.account_service.FieldFilter.prototype.setOperator = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional google.protobuf.Value value = 3;
+ * @return {?proto.google.protobuf.Value}
+ */
+proto.account_service.FieldFilter.prototype.getValue = function() {
+  return /** @type{?proto.google.protobuf.Value} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Value, 3));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Value|undefined} value
+ * @return {!proto.account_service.FieldFilter} returns this
+*/
+proto.account_service.FieldFilter.prototype.setValue = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.FieldFilter} returns this
+ */
+proto.account_service.FieldFilter.prototype.clearValue = function() {
+  return this.setValue(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.FieldFilter.prototype.hasValue = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.SortField.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.SortField.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.SortField} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.SortField.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    field: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    desc: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.SortField}
+ */
+proto.account_service.SortField.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.SortField;
+  return proto.account_service.SortField.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.SortField} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.SortField}
+ */
+proto.account_service.SortField.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setField(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDesc(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.SortField.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.SortField.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.SortField} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.SortField.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getField();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDesc();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string field = 1;
+ * @return {string}
+ */
+proto.account_service.SortField.prototype.getField = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.SortField} returns this
+ */
+proto.account_service.SortField.prototype.setField = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool desc = 2;
+ * @return {boolean}
+ */
+proto.account_service.SortField.prototype.getDesc = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.account_service.SortField} returns this
+ */
+proto.account_service.SortField.prototype.setDesc = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.ListAccountsRequest.repeatedFields_ = [3,4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.ListAccountsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ListAccountsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.ListAccountsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ListAccountsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pageSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    pageNumber: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    filtersList: jspb.Message.toObjectList(msg.getFiltersList(),
+    proto.account_service.FieldFilter.toObject, includeInstance),
+    orderByList: jspb.Message.toObjectList(msg.getOrderByList(),
+    proto.account_service.SortField.toObject, includeInstance),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.ListAccountsRequest}
+ */
+proto.account_service.ListAccountsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.ListAccountsRequest;
+  return proto.account_service.ListAccountsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.ListAccountsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.ListAccountsRequest}
+ */
+proto.account_service.ListAccountsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageNumber(value);
+      break;
+    case 3:
+      var value = new proto.account_service.FieldFilter;
+      reader.readMessage(value,proto.account_service.FieldFilter.deserializeBinaryFromReader);
+      msg.addFilters(value);
+      break;
+    case 4:
+      var value = new proto.account_service.SortField;
+      reader.readMessage(value,proto.account_service.SortField.deserializeBinaryFromReader);
+      msg.addOrderBy(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.ListAccountsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.ListAccountsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.ListAccountsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ListAccountsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getPageNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.account_service.FieldFilter.serializeBinaryToWriter
+    );
+  }
+  f = message.getOrderByList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.account_service.SortField.serializeBinaryToWriter
+    );
+  }
+  f = message.getPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 page_size = 1;
+ * @return {number}
+ */
+proto.account_service.ListAccountsRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListAccountsRequest} returns this
+ */
+proto.account_service.ListAccountsRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int32 page_number = 2;
+ * @return {number}
+ */
+proto.account_service.ListAccountsRequest.prototype.getPageNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListAccountsRequest} returns this
+ */
+proto.account_service.ListAccountsRequest.prototype.setPageNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * repeated FieldFilter filters = 3;
+ * @return {!Array<!proto.account_service.FieldFilter>}
+ */
+proto.account_service.ListAccountsRequest.prototype.getFiltersList = function() {
+  return /** @type{!Array<!proto.account_service.FieldFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.FieldFilter, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.FieldFilter>} value
+ * @return {!proto.account_service.ListAccountsRequest} returns this
+*/
+proto.account_service.ListAccountsRequest.prototype.setFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.account_service.FieldFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.FieldFilter}
+ */
+proto.account_service.ListAccountsRequest.prototype.addFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.account_service.FieldFilter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.ListAccountsRequest} returns this
+ */
+proto.account_service.ListAccountsRequest.prototype.clearFiltersList = function() {
+  return this.setFiltersList([]);
+};
+
+
+/**
+ * repeated SortField order_by = 4;
+ * @return {!Array<!proto.account_service.SortField>}
+ */
+proto.account_service.ListAccountsRequest.prototype.getOrderByList = function() {
+  return /** @type{!Array<!proto.account_service.SortField>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.SortField, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.SortField>} value
+ * @return {!proto.account_service.ListAccountsRequest} returns this
+*/
+proto.account_service.ListAccountsRequest.prototype.setOrderByList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.account_service.SortField=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.SortField}
+ */
+proto.account_service.ListAccountsRequest.prototype.addOrderBy = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.account_service.SortField, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.ListAccountsRequest} returns this
+ */
+proto.account_service.ListAccountsRequest.prototype.clearOrderByList = function() {
+  return this.setOrderByList([]);
+};
+
+
+/**
+ * optional string page_token = 5;
+ * @return {string}
+ */
+proto.account_service.ListAccountsRequest.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ListAccountsRequest} returns this
+ */
+proto.account_service.ListAccountsRequest.prototype.setPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.ListAccountsResponse.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.ListAccountsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ListAccountsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.ListAccountsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ListAccountsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pageSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    pageNumber: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    totalCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    accountsList: jspb.Message.toObjectList(msg.getAccountsList(),
+    proto.account_service.Account.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.ListAccountsResponse}
+ */
+proto.account_service.ListAccountsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.ListAccountsResponse;
+  return proto.account_service.ListAccountsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.ListAccountsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.ListAccountsResponse}
+ */
+proto.account_service.ListAccountsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageNumber(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTotalCount(value);
+      break;
+    case 4:
+      var value = new proto.account_service.Account;
+      reader.readMessage(value,proto.account_service.Account.deserializeBinaryFromReader);
+      msg.addAccounts(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.ListAccountsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.ListAccountsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.ListAccountsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ListAccountsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getPageNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getTotalCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getAccountsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.account_service.Account.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 page_size = 1;
+ * @return {number}
+ */
+proto.account_service.ListAccountsResponse.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListAccountsResponse} returns this
+ */
+proto.account_service.ListAccountsResponse.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int32 page_number = 2;
+ * @return {number}
+ */
+proto.account_service.ListAccountsResponse.prototype.getPageNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListAccountsResponse} returns this
+ */
+proto.account_service.ListAccountsResponse.prototype.setPageNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 total_count = 3;
+ * @return {number}
+ */
+proto.account_service.ListAccountsResponse.prototype.getTotalCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListAccountsResponse} returns this
+ */
+proto.account_service.ListAccountsResponse.prototype.setTotalCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * repeated Account accounts = 4;
+ * @return {!Array<!proto.account_service.Account>}
+ */
+proto.account_service.ListAccountsResponse.prototype.getAccountsList = function() {
+  return /** @type{!Array<!proto.account_service.Account>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.Account, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.Account>} value
+ * @return {!proto.account_service.ListAccountsResponse} returns this
+*/
+proto.account_service.ListAccountsResponse.prototype.setAccountsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.account_service.Account=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.Account}
+ */
+proto.account_service.ListAccountsResponse.prototype.addAccounts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.account_service.Account, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.ListAccountsResponse} returns this
+ */
+proto.account_service.ListAccountsResponse.prototype.clearAccountsList = function() {
+  return this.setAccountsList([]);
+};
+
+
+/**
+ * optional string next_page_token = 5;
+ * @return {string}
+ */
+proto.account_service.ListAccountsResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ListAccountsResponse} returns this
+ */
+proto.account_service.ListAccountsResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.account_service.GetAccountRequest.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.account_service.GetAccountRequest.SelectorCase = {
+  SELECTOR_NOT_SET: 0,
+  ID: 1,
+  NUMBER: 2,
+  IBAN: 3
+};
+
+/**
+ * @return {proto.account_service.GetAccountRequest.SelectorCase}
+ */
+proto.account_service.GetAccountRequest.prototype.getSelectorCase = function() {
+  return /** @type {proto.account_service.GetAccountRequest.SelectorCase} */(jspb.Message.computeOneofCase(this, proto.account_service.GetAccountRequest.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.GetAccountRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetAccountRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetAccountRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    number: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    iban: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetAccountRequest}
+ */
+proto.account_service.GetAccountRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetAccountRequest;
+  return proto.account_service.GetAccountRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetAccountRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetAccountRequest}
+ */
+proto.account_service.GetAccountRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNumber(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIban(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.GetAccountRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetAccountRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetAccountRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = /** @type {string} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.account_service.GetAccountRequest.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetAccountRequest} returns this
+ */
+proto.account_service.GetAccountRequest.prototype.setId = function(value) {
+  return jspb.Message.setOneofField(this, 1, proto.account_service.GetAccountRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.account_service.GetAccountRequest} returns this
+ */
+proto.account_service.GetAccountRequest.prototype.clearId = function() {
+  return jspb.Message.setOneofField(this, 1, proto.account_service.GetAccountRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.GetAccountRequest.prototype.hasId = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string number = 2;
+ * @return {string}
+ */
+proto.account_service.GetAccountRequest.prototype.getNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetAccountRequest} returns this
+ */
+proto.account_service.GetAccountRequest.prototype.setNumber = function(value) {
+  return jspb.Message.setOneofField(this, 2, proto.account_service.GetAccountRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.account_service.GetAccountRequest} returns this
+ */
+proto.account_service.GetAccountRequest.prototype.clearNumber = function() {
+  return jspb.Message.setOneofField(this, 2, proto.account_service.GetAccountRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.GetAccountRequest.prototype.hasNumber = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional string iban = 3;
+ * @return {string}
+ */
+proto.account_service.GetAccountRequest.prototype.getIban = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetAccountRequest} returns this
+ */
+proto.account_service.GetAccountRequest.prototype.setIban = function(value) {
+  return jspb.Message.setOneofField(this, 3, proto.account_service.GetAccountRequest.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.account_service.GetAccountRequest} returns this
+ */
+proto.account_service.GetAccountRequest.prototype.clearIban = function() {
+  return jspb.Message.setOneofField(this, 3, proto.account_service.GetAccountRequest.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.GetAccountRequest.prototype.hasIban = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.GetAccountResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetAccountResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetAccountResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    account: (f = msg.getAccount()) && proto.account_service.Account.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetAccountResponse}
+ */
+proto.account_service.GetAccountResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetAccountResponse;
+  return proto.account_service.GetAccountResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetAccountResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetAccountResponse}
+ */
+proto.account_service.GetAccountResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.account_service.Account;
+      reader.readMessage(value,proto.account_service.Account.deserializeBinaryFromReader);
+      msg.setAccount(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.GetAccountResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetAccountResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetAccountResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccount();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.account_service.Account.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Account account = 1;
+ * @return {?proto.account_service.Account}
+ */
+proto.account_service.GetAccountResponse.prototype.getAccount = function() {
+  return /** @type{?proto.account_service.Account} */ (
+    jspb.Message.getWrapperField(this, proto.account_service.Account, 1));
+};
+
+
+/**
+ * @param {?proto.account_service.Account|undefined} value
+ * @return {!proto.account_service.GetAccountResponse} returns this
+*/
+proto.account_service.GetAccountResponse.prototype.setAccount = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.GetAccountResponse} returns this
+ */
+proto.account_service.GetAccountResponse.prototype.clearAccount = function() {
+  return this.setAccount(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.GetAccountResponse.prototype.hasAccount = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.ListTransactionsRequest.repeatedFields_ = [4,5];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.ListTransactionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ListTransactionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.ListTransactionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ListTransactionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pageSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    pageNumber: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    filtersList: jspb.Message.toObjectList(msg.getFiltersList(),
+    proto.account_service.FieldFilter.toObject, includeInstance),
+    orderByList: jspb.Message.toObjectList(msg.getOrderByList(),
+    proto.account_service.SortField.toObject, includeInstance),
+    pageToken: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.ListTransactionsRequest}
+ */
+proto.account_service.ListTransactionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.ListTransactionsRequest;
+  return proto.account_service.ListTransactionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.ListTransactionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.ListTransactionsRequest}
+ */
+proto.account_service.ListTransactionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageNumber(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    case 4:
+      var value = new proto.account_service.FieldFilter;
+      reader.readMessage(value,proto.account_service.FieldFilter.deserializeBinaryFromReader);
+      msg.addFilters(value);
+      break;
+    case 5:
+      var value = new proto.account_service.SortField;
+      reader.readMessage(value,proto.account_service.SortField.deserializeBinaryFromReader);
+      msg.addOrderBy(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.ListTransactionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.ListTransactionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.ListTransactionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ListTransactionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getPageNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.account_service.FieldFilter.serializeBinaryToWriter
+    );
+  }
+  f = message.getOrderByList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.account_service.SortField.serializeBinaryToWriter
+    );
+  }
+  f = message.getPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 page_size = 1;
+ * @return {number}
+ */
+proto.account_service.ListTransactionsRequest.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListTransactionsRequest} returns this
+ */
+proto.account_service.ListTransactionsRequest.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int32 page_number = 2;
+ * @return {number}
+ */
+proto.account_service.ListTransactionsRequest.prototype.getPageNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListTransactionsRequest} returns this
+ */
+proto.account_service.ListTransactionsRequest.prototype.setPageNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string account_number = 3;
+ * @return {string}
+ */
+proto.account_service.ListTransactionsRequest.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ListTransactionsRequest} returns this
+ */
+proto.account_service.ListTransactionsRequest.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * repeated FieldFilter filters = 4;
+ * @return {!Array<!proto.account_service.FieldFilter>}
+ */
+proto.account_service.ListTransactionsRequest.prototype.getFiltersList = function() {
+  return /** @type{!Array<!proto.account_service.FieldFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.FieldFilter, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.FieldFilter>} value
+ * @return {!proto.account_service.ListTransactionsRequest} returns this
+*/
+proto.account_service.ListTransactionsRequest.prototype.setFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.account_service.FieldFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.FieldFilter}
+ */
+proto.account_service.ListTransactionsRequest.prototype.addFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.account_service.FieldFilter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.ListTransactionsRequest} returns this
+ */
+proto.account_service.ListTransactionsRequest.prototype.clearFiltersList = function() {
+  return this.setFiltersList([]);
+};
+
+
+/**
+ * repeated SortField order_by = 5;
+ * @return {!Array<!proto.account_service.SortField>}
+ */
+proto.account_service.ListTransactionsRequest.prototype.getOrderByList = function() {
+  return /** @type{!Array<!proto.account_service.SortField>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.SortField, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.SortField>} value
+ * @return {!proto.account_service.ListTransactionsRequest} returns this
+*/
+proto.account_service.ListTransactionsRequest.prototype.setOrderByList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.account_service.SortField=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.SortField}
+ */
+proto.account_service.ListTransactionsRequest.prototype.addOrderBy = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.account_service.SortField, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.ListTransactionsRequest} returns this
+ */
+proto.account_service.ListTransactionsRequest.prototype.clearOrderByList = function() {
+  return this.setOrderByList([]);
+};
+
+
+/**
+ * optional string page_token = 6;
+ * @return {string}
+ */
+proto.account_service.ListTransactionsRequest.prototype.getPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ListTransactionsRequest} returns this
+ */
+proto.account_service.ListTransactionsRequest.prototype.setPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.ListTransactionsResponse.repeatedFields_ = [4];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.ListTransactionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ListTransactionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.ListTransactionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ListTransactionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    pageSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    pageNumber: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    totalCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    transactionsList: jspb.Message.toObjectList(msg.getTransactionsList(),
+    proto.account_service.Transaction.toObject, includeInstance),
+    nextPageToken: jspb.Message.getFieldWithDefault(msg, 5, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.ListTransactionsResponse}
+ */
+proto.account_service.ListTransactionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.ListTransactionsResponse;
+  return proto.account_service.ListTransactionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.ListTransactionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.ListTransactionsResponse}
+ */
+proto.account_service.ListTransactionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageSize(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setPageNumber(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTotalCount(value);
+      break;
+    case 4:
+      var value = new proto.account_service.Transaction;
+      reader.readMessage(value,proto.account_service.Transaction.deserializeBinaryFromReader);
+      msg.addTransactions(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNextPageToken(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.ListTransactionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.ListTransactionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.ListTransactionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ListTransactionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPageSize();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getPageNumber();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getTotalCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getTransactionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.account_service.Transaction.serializeBinaryToWriter
+    );
+  }
+  f = message.getNextPageToken();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 page_size = 1;
+ * @return {number}
+ */
+proto.account_service.ListTransactionsResponse.prototype.getPageSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListTransactionsResponse} returns this
+ */
+proto.account_service.ListTransactionsResponse.prototype.setPageSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int32 page_number = 2;
+ * @return {number}
+ */
+proto.account_service.ListTransactionsResponse.prototype.getPageNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListTransactionsResponse} returns this
+ */
+proto.account_service.ListTransactionsResponse.prototype.setPageNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 total_count = 3;
+ * @return {number}
+ */
+proto.account_service.ListTransactionsResponse.prototype.getTotalCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.ListTransactionsResponse} returns this
+ */
+proto.account_service.ListTransactionsResponse.prototype.setTotalCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * repeated Transaction transactions = 4;
+ * @return {!Array<!proto.account_service.Transaction>}
+ */
+proto.account_service.ListTransactionsResponse.prototype.getTransactionsList = function() {
+  return /** @type{!Array<!proto.account_service.Transaction>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.Transaction, 4));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.Transaction>} value
+ * @return {!proto.account_service.ListTransactionsResponse} returns this
+*/
+proto.account_service.ListTransactionsResponse.prototype.setTransactionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.account_service.Transaction=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.Transaction}
+ */
+proto.account_service.ListTransactionsResponse.prototype.addTransactions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.account_service.Transaction, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.ListTransactionsResponse} returns this
+ */
+proto.account_service.ListTransactionsResponse.prototype.clearTransactionsList = function() {
+  return this.setTransactionsList([]);
+};
+
+
+/**
+ * optional string next_page_token = 5;
+ * @return {string}
+ */
+proto.account_service.ListTransactionsResponse.prototype.getNextPageToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ListTransactionsResponse} returns this
+ */
+proto.account_service.ListTransactionsResponse.prototype.setNextPageToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.ExportTransactionsRequest.repeatedFields_ = [2,3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.ExportTransactionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ExportTransactionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.ExportTransactionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ExportTransactionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    filtersList: jspb.Message.toObjectList(msg.getFiltersList(),
+    proto.account_service.FieldFilter.toObject, includeInstance),
+    orderByList: jspb.Message.toObjectList(msg.getOrderByList(),
+    proto.account_service.SortField.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.ExportTransactionsRequest}
+ */
+proto.account_service.ExportTransactionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.ExportTransactionsRequest;
+  return proto.account_service.ExportTransactionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.ExportTransactionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.ExportTransactionsRequest}
+ */
+proto.account_service.ExportTransactionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    case 2:
+      var value = new proto.account_service.FieldFilter;
+      reader.readMessage(value,proto.account_service.FieldFilter.deserializeBinaryFromReader);
+      msg.addFilters(value);
+      break;
+    case 3:
+      var value = new proto.account_service.SortField;
+      reader.readMessage(value,proto.account_service.SortField.deserializeBinaryFromReader);
+      msg.addOrderBy(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.ExportTransactionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.ExportTransactionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.ExportTransactionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ExportTransactionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFiltersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.account_service.FieldFilter.serializeBinaryToWriter
+    );
+  }
+  f = message.getOrderByList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.account_service.SortField.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.ExportTransactionsRequest.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ExportTransactionsRequest} returns this
+ */
+proto.account_service.ExportTransactionsRequest.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated FieldFilter filters = 2;
+ * @return {!Array<!proto.account_service.FieldFilter>}
+ */
+proto.account_service.ExportTransactionsRequest.prototype.getFiltersList = function() {
+  return /** @type{!Array<!proto.account_service.FieldFilter>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.FieldFilter, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.FieldFilter>} value
+ * @return {!proto.account_service.ExportTransactionsRequest} returns this
+*/
+proto.account_service.ExportTransactionsRequest.prototype.setFiltersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.account_service.FieldFilter=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.FieldFilter}
+ */
+proto.account_service.ExportTransactionsRequest.prototype.addFilters = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.account_service.FieldFilter, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.ExportTransactionsRequest} returns this
+ */
+proto.account_service.ExportTransactionsRequest.prototype.clearFiltersList = function() {
+  return this.setFiltersList([]);
+};
+
+
+/**
+ * repeated SortField order_by = 3;
+ * @return {!Array<!proto.account_service.SortField>}
+ */
+proto.account_service.ExportTransactionsRequest.prototype.getOrderByList = function() {
+  return /** @type{!Array<!proto.account_service.SortField>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.SortField, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.SortField>} value
+ * @return {!proto.account_service.ExportTransactionsRequest} returns this
+*/
+proto.account_service.ExportTransactionsRequest.prototype.setOrderByList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.account_service.SortField=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.SortField}
+ */
+proto.account_service.ExportTransactionsRequest.prototype.addOrderBy = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.account_service.SortField, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.ExportTransactionsRequest} returns this
+ */
+proto.account_service.ExportTransactionsRequest.prototype.clearOrderByList = function() {
+  return this.setOrderByList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.CreateTransactionsRequest.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.CreateTransactionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.CreateTransactionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.CreateTransactionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateTransactionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    transactionsList: jspb.Message.toObjectList(msg.getTransactionsList(),
+    proto.account_service.Transaction.toObject, includeInstance),
+    allOrNothing: jspb.Message.getBooleanFieldWithDefault(msg, 2, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.CreateTransactionsRequest}
+ */
+proto.account_service.CreateTransactionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.CreateTransactionsRequest;
+  return proto.account_service.CreateTransactionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.CreateTransactionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.CreateTransactionsRequest}
+ */
+proto.account_service.CreateTransactionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.account_service.Transaction;
+      reader.readMessage(value,proto.account_service.Transaction.deserializeBinaryFromReader);
+      msg.addTransactions(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setAllOrNothing(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.CreateTransactionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.CreateTransactionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.CreateTransactionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateTransactionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTransactionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.account_service.Transaction.serializeBinaryToWriter
+    );
+  }
+  f = message.getAllOrNothing();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated Transaction transactions = 1;
+ * @return {!Array<!proto.account_service.Transaction>}
+ */
+proto.account_service.CreateTransactionsRequest.prototype.getTransactionsList = function() {
+  return /** @type{!Array<!proto.account_service.Transaction>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.Transaction, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.Transaction>} value
+ * @return {!proto.account_service.CreateTransactionsRequest} returns this
+*/
+proto.account_service.CreateTransactionsRequest.prototype.setTransactionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.account_service.Transaction=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.Transaction}
+ */
+proto.account_service.CreateTransactionsRequest.prototype.addTransactions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.account_service.Transaction, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.CreateTransactionsRequest} returns this
+ */
+proto.account_service.CreateTransactionsRequest.prototype.clearTransactionsList = function() {
+  return this.setTransactionsList([]);
+};
+
+
+/**
+ * optional bool all_or_nothing = 2;
+ * @return {boolean}
+ */
+proto.account_service.CreateTransactionsRequest.prototype.getAllOrNothing = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.account_service.CreateTransactionsRequest} returns this
+ */
+proto.account_service.CreateTransactionsRequest.prototype.setAllOrNothing = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.CreateTransactionsResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.CreateTransactionsResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.CreateTransactionsResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateTransactionsResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    code: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    error: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.CreateTransactionsResult}
+ */
+proto.account_service.CreateTransactionsResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.CreateTransactionsResult;
+  return proto.account_service.CreateTransactionsResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.CreateTransactionsResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.CreateTransactionsResult}
+ */
+proto.account_service.CreateTransactionsResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCode(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.CreateTransactionsResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.CreateTransactionsResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.CreateTransactionsResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateTransactionsResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getError();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.account_service.CreateTransactionsResult.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.CreateTransactionsResult} returns this
+ */
+proto.account_service.CreateTransactionsResult.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 code = 2;
+ * @return {number}
+ */
+proto.account_service.CreateTransactionsResult.prototype.getCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.CreateTransactionsResult} returns this
+ */
+proto.account_service.CreateTransactionsResult.prototype.setCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string error = 3;
+ * @return {string}
+ */
+proto.account_service.CreateTransactionsResult.prototype.getError = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.CreateTransactionsResult} returns this
+ */
+proto.account_service.CreateTransactionsResult.prototype.setError = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.CreateTransactionsResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.CreateTransactionsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.CreateTransactionsResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.CreateTransactionsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateTransactionsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resultsList: jspb.Message.toObjectList(msg.getResultsList(),
+    proto.account_service.CreateTransactionsResult.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.CreateTransactionsResponse}
+ */
+proto.account_service.CreateTransactionsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.CreateTransactionsResponse;
+  return proto.account_service.CreateTransactionsResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.CreateTransactionsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.CreateTransactionsResponse}
+ */
+proto.account_service.CreateTransactionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.account_service.CreateTransactionsResult;
+      reader.readMessage(value,proto.account_service.CreateTransactionsResult.deserializeBinaryFromReader);
+      msg.addResults(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.CreateTransactionsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.CreateTransactionsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.CreateTransactionsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateTransactionsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResultsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.account_service.CreateTransactionsResult.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated CreateTransactionsResult results = 1;
+ * @return {!Array<!proto.account_service.CreateTransactionsResult>}
+ */
+proto.account_service.CreateTransactionsResponse.prototype.getResultsList = function() {
+  return /** @type{!Array<!proto.account_service.CreateTransactionsResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.CreateTransactionsResult, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.CreateTransactionsResult>} value
+ * @return {!proto.account_service.CreateTransactionsResponse} returns this
+*/
+proto.account_service.CreateTransactionsResponse.prototype.setResultsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.account_service.CreateTransactionsResult=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.CreateTransactionsResult}
+ */
+proto.account_service.CreateTransactionsResponse.prototype.addResults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.account_service.CreateTransactionsResult, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.CreateTransactionsResponse} returns this
+ */
+proto.account_service.CreateTransactionsResponse.prototype.clearResultsList = function() {
+  return this.setResultsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.WatchTransactionsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.WatchTransactionsRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.WatchTransactionsRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.WatchTransactionsRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.WatchTransactionsRequest}
+ */
+proto.account_service.WatchTransactionsRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.WatchTransactionsRequest;
+  return proto.account_service.WatchTransactionsRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.WatchTransactionsRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.WatchTransactionsRequest}
+ */
+proto.account_service.WatchTransactionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.WatchTransactionsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.WatchTransactionsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.WatchTransactionsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.WatchTransactionsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.WatchTransactionsRequest.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.WatchTransactionsRequest} returns this
+ */
+proto.account_service.WatchTransactionsRequest.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.CreateAccountResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.CreateAccountResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.CreateAccountResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateAccountResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.CreateAccountResponse}
+ */
+proto.account_service.CreateAccountResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.CreateAccountResponse;
+  return proto.account_service.CreateAccountResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.CreateAccountResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.CreateAccountResponse}
+ */
+proto.account_service.CreateAccountResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.CreateAccountResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.CreateAccountResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.CreateAccountResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateAccountResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.account_service.CreateAccountResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.CreateAccountResponse} returns this
+ */
+proto.account_service.CreateAccountResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.UpdateAccountRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.UpdateAccountRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.UpdateAccountRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.UpdateAccountRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    number: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    address: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    iban: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    updateMask: (f = msg.getUpdateMask()) && google_protobuf_field_mask_pb.FieldMask.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.UpdateAccountRequest}
+ */
+proto.account_service.UpdateAccountRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.UpdateAccountRequest;
+  return proto.account_service.UpdateAccountRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.UpdateAccountRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.UpdateAccountRequest}
+ */
+proto.account_service.UpdateAccountRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNumber(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAddress(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIban(value);
+      break;
+    case 5:
+      var value = new google_protobuf_field_mask_pb.FieldMask;
+      reader.readMessage(value,google_protobuf_field_mask_pb.FieldMask.deserializeBinaryFromReader);
+      msg.setUpdateMask(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.UpdateAccountRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.UpdateAccountRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.UpdateAccountRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.UpdateAccountRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAddress();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getIban();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getUpdateMask();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_field_mask_pb.FieldMask.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string number = 1;
+ * @return {string}
+ */
+proto.account_service.UpdateAccountRequest.prototype.getNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.UpdateAccountRequest} returns this
+ */
+proto.account_service.UpdateAccountRequest.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.account_service.UpdateAccountRequest.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.UpdateAccountRequest} returns this
+ */
+proto.account_service.UpdateAccountRequest.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string address = 3;
+ * @return {string}
+ */
+proto.account_service.UpdateAccountRequest.prototype.getAddress = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.UpdateAccountRequest} returns this
+ */
+proto.account_service.UpdateAccountRequest.prototype.setAddress = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string iban = 4;
+ * @return {string}
+ */
+proto.account_service.UpdateAccountRequest.prototype.getIban = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.UpdateAccountRequest} returns this
+ */
+proto.account_service.UpdateAccountRequest.prototype.setIban = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional google.protobuf.FieldMask update_mask = 5;
+ * @return {?proto.google.protobuf.FieldMask}
+ */
+proto.account_service.UpdateAccountRequest.prototype.getUpdateMask = function() {
+  return /** @type{?proto.google.protobuf.FieldMask} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_field_mask_pb.FieldMask, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.FieldMask|undefined} value
+ * @return {!proto.account_service.UpdateAccountRequest} returns this
+*/
+proto.account_service.UpdateAccountRequest.prototype.setUpdateMask = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.UpdateAccountRequest} returns this
+ */
+proto.account_service.UpdateAccountRequest.prototype.clearUpdateMask = function() {
+  return this.setUpdateMask(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.UpdateAccountRequest.prototype.hasUpdateMask = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.UpdateAccountResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.UpdateAccountResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.UpdateAccountResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.UpdateAccountResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    account: (f = msg.getAccount()) && proto.account_service.Account.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.UpdateAccountResponse}
+ */
+proto.account_service.UpdateAccountResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.UpdateAccountResponse;
+  return proto.account_service.UpdateAccountResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.UpdateAccountResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.UpdateAccountResponse}
+ */
+proto.account_service.UpdateAccountResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.account_service.Account;
+      reader.readMessage(value,proto.account_service.Account.deserializeBinaryFromReader);
+      msg.setAccount(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.UpdateAccountResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.UpdateAccountResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.UpdateAccountResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.UpdateAccountResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccount();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.account_service.Account.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional Account account = 1;
+ * @return {?proto.account_service.Account}
+ */
+proto.account_service.UpdateAccountResponse.prototype.getAccount = function() {
+  return /** @type{?proto.account_service.Account} */ (
+    jspb.Message.getWrapperField(this, proto.account_service.Account, 1));
+};
+
+
+/**
+ * @param {?proto.account_service.Account|undefined} value
+ * @return {!proto.account_service.UpdateAccountResponse} returns this
+*/
+proto.account_service.UpdateAccountResponse.prototype.setAccount = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.UpdateAccountResponse} returns this
+ */
+proto.account_service.UpdateAccountResponse.prototype.clearAccount = function() {
+  return this.setAccount(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.UpdateAccountResponse.prototype.hasAccount = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.GetAccountHistoryRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetAccountHistoryRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetAccountHistoryRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountHistoryRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetAccountHistoryRequest}
+ */
+proto.account_service.GetAccountHistoryRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetAccountHistoryRequest;
+  return proto.account_service.GetAccountHistoryRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetAccountHistoryRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetAccountHistoryRequest}
+ */
+proto.account_service.GetAccountHistoryRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.GetAccountHistoryRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetAccountHistoryRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetAccountHistoryRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountHistoryRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.GetAccountHistoryRequest.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetAccountHistoryRequest} returns this
+ */
+proto.account_service.GetAccountHistoryRequest.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.AccountRevision.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.AccountRevision.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.AccountRevision} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.AccountRevision.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    revision: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    transactionId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    account: (f = msg.getAccount()) && proto.account_service.Account.toObject(includeInstance, f),
+    creator: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    ts: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    actor: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.AccountRevision}
+ */
+proto.account_service.AccountRevision.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.AccountRevision;
+  return proto.account_service.AccountRevision.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.AccountRevision} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.AccountRevision}
+ */
+proto.account_service.AccountRevision.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRevision(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTransactionId(value);
+      break;
+    case 3:
+      var value = new proto.account_service.Account;
+      reader.readMessage(value,proto.account_service.Account.deserializeBinaryFromReader);
+      msg.setAccount(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCreator(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTs(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setActor(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.AccountRevision.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.AccountRevision.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.AccountRevision} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.AccountRevision.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRevision();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getTransactionId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getAccount();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.account_service.Account.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreator();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getTs();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
+      f
+    );
+  }
+  f = message.getActor();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string revision = 1;
+ * @return {string}
+ */
+proto.account_service.AccountRevision.prototype.getRevision = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.AccountRevision} returns this
+ */
+proto.account_service.AccountRevision.prototype.setRevision = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string transaction_id = 2;
+ * @return {string}
+ */
+proto.account_service.AccountRevision.prototype.getTransactionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.AccountRevision} returns this
+ */
+proto.account_service.AccountRevision.prototype.setTransactionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional Account account = 3;
+ * @return {?proto.account_service.Account}
+ */
+proto.account_service.AccountRevision.prototype.getAccount = function() {
+  return /** @type{?proto.account_service.Account} */ (
+    jspb.Message.getWrapperField(this, proto.account_service.Account, 3));
+};
+
+
+/**
+ * @param {?proto.account_service.Account|undefined} value
+ * @return {!proto.account_service.AccountRevision} returns this
+*/
+proto.account_service.AccountRevision.prototype.setAccount = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.AccountRevision} returns this
+ */
+proto.account_service.AccountRevision.prototype.clearAccount = function() {
+  return this.setAccount(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.AccountRevision.prototype.hasAccount = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional string creator = 4;
+ * @return {string}
+ */
+proto.account_service.AccountRevision.prototype.getCreator = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.AccountRevision} returns this
+ */
+proto.account_service.AccountRevision.prototype.setCreator = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional int64 ts = 5;
+ * @return {number}
+ */
+proto.account_service.AccountRevision.prototype.getTs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.AccountRevision} returns this
+ */
+proto.account_service.AccountRevision.prototype.setTs = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string actor = 6;
+ * @return {string}
+ */
+proto.account_service.AccountRevision.prototype.getActor = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.AccountRevision} returns this
+ */
+proto.account_service.AccountRevision.prototype.setActor = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.AccountDiff.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.AccountDiff.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.AccountDiff} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.AccountDiff.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    diffIds: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    diff: (f = msg.getDiff()) && google_protobuf_struct_pb.Struct.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.AccountDiff}
+ */
+proto.account_service.AccountDiff.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.AccountDiff;
+  return proto.account_service.AccountDiff.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.AccountDiff} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.AccountDiff}
+ */
+proto.account_service.AccountDiff.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDiffIds(value);
+      break;
+    case 2:
+      var value = new google_protobuf_struct_pb.Struct;
+      reader.readMessage(value,google_protobuf_struct_pb.Struct.deserializeBinaryFromReader);
+      msg.setDiff(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.AccountDiff.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.AccountDiff.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.AccountDiff} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.AccountDiff.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getDiffIds();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getDiff();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      google_protobuf_struct_pb.Struct.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string diff_ids = 1;
+ * @return {string}
+ */
+proto.account_service.AccountDiff.prototype.getDiffIds = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.AccountDiff} returns this
+ */
+proto.account_service.AccountDiff.prototype.setDiffIds = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional google.protobuf.Struct diff = 2;
+ * @return {?proto.google.protobuf.Struct}
+ */
+proto.account_service.AccountDiff.prototype.getDiff = function() {
+  return /** @type{?proto.google.protobuf.Struct} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_struct_pb.Struct, 2));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Struct|undefined} value
+ * @return {!proto.account_service.AccountDiff} returns this
+*/
+proto.account_service.AccountDiff.prototype.setDiff = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.AccountDiff} returns this
+ */
+proto.account_service.AccountDiff.prototype.clearDiff = function() {
+  return this.setDiff(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.AccountDiff.prototype.hasDiff = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.GetAccountHistoryResponse.repeatedFields_ = [1,2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.GetAccountHistoryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetAccountHistoryResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetAccountHistoryResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountHistoryResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    revisionsList: jspb.Message.toObjectList(msg.getRevisionsList(),
+    proto.account_service.AccountRevision.toObject, includeInstance),
+    diffsList: jspb.Message.toObjectList(msg.getDiffsList(),
+    proto.account_service.AccountDiff.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetAccountHistoryResponse}
+ */
+proto.account_service.GetAccountHistoryResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetAccountHistoryResponse;
+  return proto.account_service.GetAccountHistoryResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetAccountHistoryResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetAccountHistoryResponse}
+ */
+proto.account_service.GetAccountHistoryResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.account_service.AccountRevision;
+      reader.readMessage(value,proto.account_service.AccountRevision.deserializeBinaryFromReader);
+      msg.addRevisions(value);
+      break;
+    case 2:
+      var value = new proto.account_service.AccountDiff;
+      reader.readMessage(value,proto.account_service.AccountDiff.deserializeBinaryFromReader);
+      msg.addDiffs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.GetAccountHistoryResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetAccountHistoryResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetAccountHistoryResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountHistoryResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRevisionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.account_service.AccountRevision.serializeBinaryToWriter
+    );
+  }
+  f = message.getDiffsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.account_service.AccountDiff.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated AccountRevision revisions = 1;
+ * @return {!Array<!proto.account_service.AccountRevision>}
+ */
+proto.account_service.GetAccountHistoryResponse.prototype.getRevisionsList = function() {
+  return /** @type{!Array<!proto.account_service.AccountRevision>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.AccountRevision, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.AccountRevision>} value
+ * @return {!proto.account_service.GetAccountHistoryResponse} returns this
+*/
+proto.account_service.GetAccountHistoryResponse.prototype.setRevisionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.account_service.AccountRevision=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.AccountRevision}
+ */
+proto.account_service.GetAccountHistoryResponse.prototype.addRevisions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.account_service.AccountRevision, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.GetAccountHistoryResponse} returns this
+ */
+proto.account_service.GetAccountHistoryResponse.prototype.clearRevisionsList = function() {
+  return this.setRevisionsList([]);
+};
+
+
+/**
+ * repeated AccountDiff diffs = 2;
+ * @return {!Array<!proto.account_service.AccountDiff>}
+ */
+proto.account_service.GetAccountHistoryResponse.prototype.getDiffsList = function() {
+  return /** @type{!Array<!proto.account_service.AccountDiff>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.AccountDiff, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.AccountDiff>} value
+ * @return {!proto.account_service.GetAccountHistoryResponse} returns this
+*/
+proto.account_service.GetAccountHistoryResponse.prototype.setDiffsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.account_service.AccountDiff=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.AccountDiff}
+ */
+proto.account_service.GetAccountHistoryResponse.prototype.addDiffs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.account_service.AccountDiff, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.GetAccountHistoryResponse} returns this
+ */
+proto.account_service.GetAccountHistoryResponse.prototype.clearDiffsList = function() {
+  return this.setDiffsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.CreateTransactionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.CreateTransactionResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.CreateTransactionResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateTransactionResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.CreateTransactionResponse}
+ */
+proto.account_service.CreateTransactionResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.CreateTransactionResponse;
+  return proto.account_service.CreateTransactionResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.CreateTransactionResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.CreateTransactionResponse}
+ */
+proto.account_service.CreateTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.CreateTransactionResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.CreateTransactionResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.CreateTransactionResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.CreateTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.account_service.CreateTransactionResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.CreateTransactionResponse} returns this
+ */
+proto.account_service.CreateTransactionResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.GetAccountBalanceRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetAccountBalanceRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetAccountBalanceRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountBalanceRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetAccountBalanceRequest}
+ */
+proto.account_service.GetAccountBalanceRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetAccountBalanceRequest;
+  return proto.account_service.GetAccountBalanceRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetAccountBalanceRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetAccountBalanceRequest}
+ */
+proto.account_service.GetAccountBalanceRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.GetAccountBalanceRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetAccountBalanceRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetAccountBalanceRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountBalanceRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.GetAccountBalanceRequest.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetAccountBalanceRequest} returns this
+ */
+proto.account_service.GetAccountBalanceRequest.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetAccountBalanceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetAccountBalanceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountBalanceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    balance: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    currency: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    currencyExponent: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetAccountBalanceResponse}
+ */
+proto.account_service.GetAccountBalanceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetAccountBalanceResponse;
+  return proto.account_service.GetAccountBalanceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetAccountBalanceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetAccountBalanceResponse}
+ */
+proto.account_service.GetAccountBalanceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBalance(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCurrency(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCurrencyExponent(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetAccountBalanceResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetAccountBalanceResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetAccountBalanceResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getBalance();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getCurrency();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getCurrencyExponent();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetAccountBalanceResponse} returns this
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int64 balance = 2;
+ * @return {number}
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.getBalance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.GetAccountBalanceResponse} returns this
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.setBalance = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string currency = 3;
+ * @return {string}
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.getCurrency = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetAccountBalanceResponse} returns this
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.setCurrency = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int32 currency_exponent = 4;
+ * @return {number}
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.getCurrencyExponent = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.GetAccountBalanceResponse} returns this
+ */
+proto.account_service.GetAccountBalanceResponse.prototype.setCurrencyExponent = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.GetStatementRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetStatementRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetStatementRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetStatementRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    fromDate: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    toDate: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetStatementRequest}
+ */
+proto.account_service.GetStatementRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetStatementRequest;
+  return proto.account_service.GetStatementRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetStatementRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetStatementRequest}
+ */
+proto.account_service.GetStatementRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFromDate(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToDate(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.GetStatementRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetStatementRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetStatementRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetStatementRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFromDate();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getToDate();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.GetStatementRequest.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetStatementRequest} returns this
+ */
+proto.account_service.GetStatementRequest.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string from_date = 2;
+ * @return {string}
+ */
+proto.account_service.GetStatementRequest.prototype.getFromDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetStatementRequest} returns this
+ */
+proto.account_service.GetStatementRequest.prototype.setFromDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string to_date = 3;
+ * @return {string}
+ */
+proto.account_service.GetStatementRequest.prototype.getToDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetStatementRequest} returns this
+ */
+proto.account_service.GetStatementRequest.prototype.setToDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.StatementLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.StatementLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.StatementLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.StatementLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    transaction: (f = msg.getTransaction()) && proto.account_service.Transaction.toObject(includeInstance, f),
+    balance: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.StatementLine}
+ */
+proto.account_service.StatementLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.StatementLine;
+  return proto.account_service.StatementLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.StatementLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.StatementLine}
+ */
+proto.account_service.StatementLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.account_service.Transaction;
+      reader.readMessage(value,proto.account_service.Transaction.deserializeBinaryFromReader);
+      msg.setTransaction(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setBalance(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.StatementLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.StatementLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.StatementLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.StatementLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTransaction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.account_service.Transaction.serializeBinaryToWriter
+    );
+  }
+  f = message.getBalance();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional Transaction transaction = 1;
+ * @return {?proto.account_service.Transaction}
+ */
+proto.account_service.StatementLine.prototype.getTransaction = function() {
+  return /** @type{?proto.account_service.Transaction} */ (
+    jspb.Message.getWrapperField(this, proto.account_service.Transaction, 1));
+};
+
+
+/**
+ * @param {?proto.account_service.Transaction|undefined} value
+ * @return {!proto.account_service.StatementLine} returns this
+*/
+proto.account_service.StatementLine.prototype.setTransaction = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.StatementLine} returns this
+ */
+proto.account_service.StatementLine.prototype.clearTransaction = function() {
+  return this.setTransaction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.StatementLine.prototype.hasTransaction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional int64 balance = 2;
+ * @return {number}
+ */
+proto.account_service.StatementLine.prototype.getBalance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.StatementLine} returns this
+ */
+proto.account_service.StatementLine.prototype.setBalance = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.account_service.GetStatementResponse.repeatedFields_ = [7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.GetStatementResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetStatementResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetStatementResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetStatementResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    currency: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    currencyExponent: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    fromDate: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    toDate: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    openingBalance: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    linesList: jspb.Message.toObjectList(msg.getLinesList(),
+    proto.account_service.StatementLine.toObject, includeInstance),
+    totalDeposits: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    totalWithdrawals: jspb.Message.getFieldWithDefault(msg, 9, 0),
+    closingBalance: jspb.Message.getFieldWithDefault(msg, 10, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetStatementResponse}
+ */
+proto.account_service.GetStatementResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetStatementResponse;
+  return proto.account_service.GetStatementResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetStatementResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetStatementResponse}
+ */
+proto.account_service.GetStatementResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCurrency(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCurrencyExponent(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFromDate(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToDate(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setOpeningBalance(value);
+      break;
+    case 7:
+      var value = new proto.account_service.StatementLine;
+      reader.readMessage(value,proto.account_service.StatementLine.deserializeBinaryFromReader);
+      msg.addLines(value);
+      break;
+    case 8:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotalDeposits(value);
+      break;
+    case 9:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTotalWithdrawals(value);
+      break;
+    case 10:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setClosingBalance(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.GetStatementResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetStatementResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetStatementResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetStatementResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCurrency();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCurrencyExponent();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getFromDate();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getToDate();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getOpeningBalance();
+  if (f !== 0) {
+    writer.writeInt64(
+      6,
+      f
+    );
+  }
+  f = message.getLinesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.account_service.StatementLine.serializeBinaryToWriter
+    );
+  }
+  f = message.getTotalDeposits();
+  if (f !== 0) {
+    writer.writeInt64(
+      8,
+      f
+    );
+  }
+  f = message.getTotalWithdrawals();
+  if (f !== 0) {
+    writer.writeInt64(
+      9,
+      f
+    );
+  }
+  f = message.getClosingBalance();
+  if (f !== 0) {
+    writer.writeInt64(
+      10,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.GetStatementResponse.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string currency = 2;
+ * @return {string}
+ */
+proto.account_service.GetStatementResponse.prototype.getCurrency = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setCurrency = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 currency_exponent = 3;
+ * @return {number}
+ */
+proto.account_service.GetStatementResponse.prototype.getCurrencyExponent = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setCurrencyExponent = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string from_date = 4;
+ * @return {string}
+ */
+proto.account_service.GetStatementResponse.prototype.getFromDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setFromDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string to_date = 5;
+ * @return {string}
+ */
+proto.account_service.GetStatementResponse.prototype.getToDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setToDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional int64 opening_balance = 6;
+ * @return {number}
+ */
+proto.account_service.GetStatementResponse.prototype.getOpeningBalance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setOpeningBalance = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * repeated StatementLine lines = 7;
+ * @return {!Array<!proto.account_service.StatementLine>}
+ */
+proto.account_service.GetStatementResponse.prototype.getLinesList = function() {
+  return /** @type{!Array<!proto.account_service.StatementLine>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.account_service.StatementLine, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.account_service.StatementLine>} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+*/
+proto.account_service.GetStatementResponse.prototype.setLinesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.account_service.StatementLine=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.account_service.StatementLine}
+ */
+proto.account_service.GetStatementResponse.prototype.addLines = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.account_service.StatementLine, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.clearLinesList = function() {
+  return this.setLinesList([]);
+};
+
+
+/**
+ * optional int64 total_deposits = 8;
+ * @return {number}
+ */
+proto.account_service.GetStatementResponse.prototype.getTotalDeposits = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setTotalDeposits = function(value) {
+  return jspb.Message.setProto3IntField(this, 8, value);
+};
+
+
+/**
+ * optional int64 total_withdrawals = 9;
+ * @return {number}
+ */
+proto.account_service.GetStatementResponse.prototype.getTotalWithdrawals = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 9, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setTotalWithdrawals = function(value) {
+  return jspb.Message.setProto3IntField(this, 9, value);
+};
+
+
+/**
+ * optional int64 closing_balance = 10;
+ * @return {number}
+ */
+proto.account_service.GetStatementResponse.prototype.getClosingBalance = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 10, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.GetStatementResponse} returns this
+ */
+proto.account_service.GetStatementResponse.prototype.setClosingBalance = function(value) {
+  return jspb.Message.setProto3IntField(this, 10, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.account_service.ExportStatementRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ExportStatementRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.ExportStatementRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ExportStatementRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    fromDate: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    toDate: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    format: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.ExportStatementRequest}
+ */
+proto.account_service.ExportStatementRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.ExportStatementRequest;
+  return proto.account_service.ExportStatementRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.ExportStatementRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.ExportStatementRequest}
+ */
+proto.account_service.ExportStatementRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAccountNumber(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFromDate(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToDate(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.account_service.StatementFormat} */ (reader.readEnum());
+      msg.setFormat(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.account_service.ExportStatementRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.ExportStatementRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.ExportStatementRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.ExportStatementRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getFromDate();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getToDate();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getFormat();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string account_number = 1;
+ * @return {string}
+ */
+proto.account_service.ExportStatementRequest.prototype.getAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ExportStatementRequest} returns this
+ */
+proto.account_service.ExportStatementRequest.prototype.setAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string from_date = 2;
+ * @return {string}
+ */
+proto.account_service.ExportStatementRequest.prototype.getFromDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ExportStatementRequest} returns this
+ */
+proto.account_service.ExportStatementRequest.prototype.setFromDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string to_date = 3;
+ * @return {string}
+ */
+proto.account_service.ExportStatementRequest.prototype.getToDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.account_service.ExportStatementRequest} returns this
  */
-proto.account_service.CreateAccountResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.account_service.ExportStatementRequest.prototype.setToDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
-goog.inherits(proto.account_service.CreateAccountResponse, jspb.Message);
-if (goog.DEBUG && !COMPILED) {
-  /**
-   * @public
-   * @override
-   */
-  proto.account_service.CreateAccountResponse.displayName = 'proto.account_service.CreateAccountResponse';
-}
+
+
 /**
- * Generated by JsPbCodeGenerator.
- * @param {Array=} opt_data Optional initial data array, typically from a
- * server response, or constructed directly in Javascript. The array is used
- * in place and becomes part of the constructed object. It is not cloned.
- * If no data is provided, the constructed object will be empty, but still
- * valid.
- * @extends {jspb.Message}
- * @constructor
+ * optional StatementFormat format = 4;
+ * @return {!proto.account_service.StatementFormat}
  */
-proto.account_service.CreateTransactionResponse = function(opt_data) {
-  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+proto.account_service.ExportStatementRequest.prototype.getFormat = function() {
+  return /** @type {!proto.account_service.StatementFormat} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
-goog.inherits(proto.account_service.CreateTransactionResponse, jspb.Message);
-if (goog.DEBUG && !COMPILED) {
-  /**
-   * @public
-   * @override
-   */
-  proto.account_service.CreateTransactionResponse.displayName = 'proto.account_service.CreateTransactionResponse';
-}
+
+
+/**
+ * @param {!proto.account_service.StatementFormat} value
+ * @return {!proto.account_service.ExportStatementRequest} returns this
+ */
+proto.account_service.ExportStatementRequest.prototype.setFormat = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
 
 
 
@@ -214,8 +7555,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.account_service.Account.prototype.toObject = function(opt_includeInstance) {
-  return proto.account_service.Account.toObject(opt_includeInstance, this);
+proto.account_service.ExportStatementResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ExportStatementResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -224,17 +7565,15 @@ proto.account_service.Account.prototype.toObject = function(opt_includeInstance)
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.account_service.Account} msg The msg instance to transform.
+ * @param {!proto.account_service.ExportStatementResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.Account.toObject = function(includeInstance, msg) {
+proto.account_service.ExportStatementResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    number: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    address: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    iban: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    id: jspb.Message.getFieldWithDefault(msg, 5, "")
+    content: msg.getContent_asB64(),
+    contentType: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    fileName: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -248,23 +7587,23 @@ proto.account_service.Account.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.account_service.Account}
+ * @return {!proto.account_service.ExportStatementResponse}
  */
-proto.account_service.Account.deserializeBinary = function(bytes) {
+proto.account_service.ExportStatementResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.account_service.Account;
-  return proto.account_service.Account.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.account_service.ExportStatementResponse;
+  return proto.account_service.ExportStatementResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.account_service.Account} msg The message object to deserialize into.
+ * @param {!proto.account_service.ExportStatementResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.account_service.Account}
+ * @return {!proto.account_service.ExportStatementResponse}
  */
-proto.account_service.Account.deserializeBinaryFromReader = function(msg, reader) {
+proto.account_service.ExportStatementResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -272,24 +7611,16 @@ proto.account_service.Account.deserializeBinaryFromReader = function(msg, reader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNumber(value);
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setContent(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setContentType(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAddress(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setIban(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setFileName(value);
       break;
     default:
       reader.skipField();
@@ -304,9 +7635,9 @@ proto.account_service.Account.deserializeBinaryFromReader = function(msg, reader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.account_service.Account.prototype.serializeBinary = function() {
+proto.account_service.ExportStatementResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.account_service.Account.serializeBinaryToWriter(this, writer);
+  proto.account_service.ExportStatementResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -314,137 +7645,111 @@ proto.account_service.Account.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.account_service.Account} message
+ * @param {!proto.account_service.ExportStatementResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.Account.serializeBinaryToWriter = function(message, writer) {
+proto.account_service.ExportStatementResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getNumber();
+  f = message.getContent_asU8();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeBytes(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getContentType();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getAddress();
+  f = message.getFileName();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getIban();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string number = 1;
- * @return {string}
- */
-proto.account_service.Account.prototype.getNumber = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.account_service.Account} returns this
+ * optional bytes content = 1;
+ * @return {!(string|Uint8Array)}
  */
-proto.account_service.Account.prototype.setNumber = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.account_service.ExportStatementResponse.prototype.getContent = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional string name = 2;
+ * optional bytes content = 1;
+ * This is a type-conversion wrapper around `getContent()`
  * @return {string}
  */
-proto.account_service.Account.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.account_service.Account} returns this
- */
-proto.account_service.Account.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.account_service.ExportStatementResponse.prototype.getContent_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getContent()));
 };
 
 
 /**
- * optional string address = 3;
- * @return {string}
+ * optional bytes content = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getContent()`
+ * @return {!Uint8Array}
  */
-proto.account_service.Account.prototype.getAddress = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.account_service.ExportStatementResponse.prototype.getContent_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getContent()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.account_service.Account} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.account_service.ExportStatementResponse} returns this
  */
-proto.account_service.Account.prototype.setAddress = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.account_service.ExportStatementResponse.prototype.setContent = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
 /**
- * optional string iban = 4;
+ * optional string content_type = 2;
  * @return {string}
  */
-proto.account_service.Account.prototype.getIban = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.account_service.ExportStatementResponse.prototype.getContentType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.account_service.Account} returns this
+ * @return {!proto.account_service.ExportStatementResponse} returns this
  */
-proto.account_service.Account.prototype.setIban = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.account_service.ExportStatementResponse.prototype.setContentType = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string id = 5;
+ * optional string file_name = 3;
  * @return {string}
  */
-proto.account_service.Account.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.account_service.ExportStatementResponse.prototype.getFileName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.account_service.Account} returns this
+ * @return {!proto.account_service.ExportStatementResponse} returns this
  */
-proto.account_service.Account.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.account_service.ExportStatementResponse.prototype.setFileName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -464,8 +7769,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.account_service.Transaction.prototype.toObject = function(opt_includeInstance) {
-  return proto.account_service.Transaction.toObject(opt_includeInstance, this);
+proto.account_service.TransferRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.TransferRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -474,16 +7779,20 @@ proto.account_service.Transaction.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.account_service.Transaction} msg The msg instance to transform.
+ * @param {!proto.account_service.TransferRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.Transaction.toObject = function(includeInstance, msg) {
+proto.account_service.TransferRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    accountNumber: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    amount: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    type: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    id: jspb.Message.getFieldWithDefault(msg, 4, "")
+    fromAccountNumber: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    toAccountNumber: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    amount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    currency: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    valueDate: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    description: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    reference: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    metadataMap: (f = msg.getMetadataMap()) ? f.toObject(includeInstance, undefined) : []
   };
 
   if (includeInstance) {
@@ -497,23 +7806,23 @@ proto.account_service.Transaction.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.account_service.Transaction}
+ * @return {!proto.account_service.TransferRequest}
  */
-proto.account_service.Transaction.deserializeBinary = function(bytes) {
+proto.account_service.TransferRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.account_service.Transaction;
-  return proto.account_service.Transaction.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.account_service.TransferRequest;
+  return proto.account_service.TransferRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.account_service.Transaction} msg The message object to deserialize into.
+ * @param {!proto.account_service.TransferRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.account_service.Transaction}
+ * @return {!proto.account_service.TransferRequest}
  */
-proto.account_service.Transaction.deserializeBinaryFromReader = function(msg, reader) {
+proto.account_service.TransferRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -522,19 +7831,37 @@ proto.account_service.Transaction.deserializeBinaryFromReader = function(msg, re
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAccountNumber(value);
+      msg.setFromAccountNumber(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setAmount(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToAccountNumber(value);
       break;
     case 3:
-      var value = /** @type {!proto.account_service.TransactionType} */ (reader.readEnum());
-      msg.setType(value);
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setAmount(value);
       break;
     case 4:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setCurrency(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValueDate(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDescription(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReference(value);
+      break;
+    case 8:
+      var value = msg.getMetadataMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
       break;
     default:
       reader.skipField();
@@ -549,9 +7876,9 @@ proto.account_service.Transaction.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.account_service.Transaction.prototype.serializeBinary = function() {
+proto.account_service.TransferRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.account_service.Transaction.serializeBinaryToWriter(this, writer);
+  proto.account_service.TransferRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -559,282 +7886,216 @@ proto.account_service.Transaction.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.account_service.Transaction} message
+ * @param {!proto.account_service.TransferRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.Transaction.serializeBinaryToWriter = function(message, writer) {
+proto.account_service.TransferRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAccountNumber();
+  f = message.getFromAccountNumber();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getAmount();
-  if (f !== 0) {
-    writer.writeInt64(
+  f = message.getToAccountNumber();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getAmount();
+  if (f !== 0) {
+    writer.writeInt64(
       3,
       f
     );
   }
-  f = message.getId();
+  f = message.getCurrency();
   if (f.length > 0) {
     writer.writeString(
       4,
       f
     );
   }
+  f = message.getValueDate();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getReference();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getMetadataMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(8, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
 };
 
 
 /**
- * optional string account_number = 1;
+ * optional string from_account_number = 1;
  * @return {string}
  */
-proto.account_service.Transaction.prototype.getAccountNumber = function() {
+proto.account_service.TransferRequest.prototype.getFromAccountNumber = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.account_service.Transaction} returns this
+ * @return {!proto.account_service.TransferRequest} returns this
  */
-proto.account_service.Transaction.prototype.setAccountNumber = function(value) {
+proto.account_service.TransferRequest.prototype.setFromAccountNumber = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int64 amount = 2;
- * @return {number}
- */
-proto.account_service.Transaction.prototype.getAmount = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.account_service.Transaction} returns this
- */
-proto.account_service.Transaction.prototype.setAmount = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional TransactionType type = 3;
- * @return {!proto.account_service.TransactionType}
- */
-proto.account_service.Transaction.prototype.getType = function() {
-  return /** @type {!proto.account_service.TransactionType} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
-};
-
-
-/**
- * @param {!proto.account_service.TransactionType} value
- * @return {!proto.account_service.Transaction} returns this
- */
-proto.account_service.Transaction.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
-};
-
-
-/**
- * optional string id = 4;
+ * optional string to_account_number = 2;
  * @return {string}
- */
-proto.account_service.Transaction.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+ */
+proto.account_service.TransferRequest.prototype.getToAccountNumber = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.account_service.Transaction} returns this
+ * @return {!proto.account_service.TransferRequest} returns this
  */
-proto.account_service.Transaction.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.account_service.TransferRequest.prototype.setToAccountNumber = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+/**
+ * optional int64 amount = 3;
+ * @return {number}
+ */
+proto.account_service.TransferRequest.prototype.getAmount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {number} value
+ * @return {!proto.account_service.TransferRequest} returns this
  */
-proto.account_service.ListAccountsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.account_service.ListAccountsRequest.toObject(opt_includeInstance, this);
+proto.account_service.TransferRequest.prototype.setAmount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.account_service.ListAccountsRequest} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string currency = 4;
+ * @return {string}
  */
-proto.account_service.ListAccountsRequest.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    pageSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    pageNumber: jspb.Message.getFieldWithDefault(msg, 2, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.account_service.TransferRequest.prototype.getCurrency = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.account_service.ListAccountsRequest}
+ * @param {string} value
+ * @return {!proto.account_service.TransferRequest} returns this
  */
-proto.account_service.ListAccountsRequest.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.account_service.ListAccountsRequest;
-  return proto.account_service.ListAccountsRequest.deserializeBinaryFromReader(msg, reader);
+proto.account_service.TransferRequest.prototype.setCurrency = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.account_service.ListAccountsRequest} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.account_service.ListAccountsRequest}
+ * optional string value_date = 5;
+ * @return {string}
  */
-proto.account_service.ListAccountsRequest.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageSize(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageNumber(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.account_service.TransferRequest.prototype.getValueDate = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @return {!proto.account_service.TransferRequest} returns this
  */
-proto.account_service.ListAccountsRequest.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.account_service.ListAccountsRequest.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.account_service.TransferRequest.prototype.setValueDate = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.account_service.ListAccountsRequest} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional string description = 6;
+ * @return {string}
  */
-proto.account_service.ListAccountsRequest.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getPageSize();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
-  f = message.getPageNumber();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
+proto.account_service.TransferRequest.prototype.getDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
 };
 
 
 /**
- * optional int32 page_size = 1;
- * @return {number}
+ * @param {string} value
+ * @return {!proto.account_service.TransferRequest} returns this
  */
-proto.account_service.ListAccountsRequest.prototype.getPageSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.account_service.TransferRequest.prototype.setDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.account_service.ListAccountsRequest} returns this
+ * optional string reference = 7;
+ * @return {string}
  */
-proto.account_service.ListAccountsRequest.prototype.setPageSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.account_service.TransferRequest.prototype.getReference = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
 };
 
 
 /**
- * optional int32 page_number = 2;
- * @return {number}
+ * @param {string} value
+ * @return {!proto.account_service.TransferRequest} returns this
  */
-proto.account_service.ListAccountsRequest.prototype.getPageNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.account_service.TransferRequest.prototype.setReference = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.account_service.ListAccountsRequest} returns this
+ * map<string, string> metadata = 8;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
  */
-proto.account_service.ListAccountsRequest.prototype.setPageNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.account_service.TransferRequest.prototype.getMetadataMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 8, opt_noLazyCreate,
+      null));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.account_service.TransferRequest} returns this
  */
-proto.account_service.ListAccountsResponse.repeatedFields_ = [4];
+proto.account_service.TransferRequest.prototype.clearMetadataMap = function() {
+  this.getMetadataMap().clear();
+  return this;};
+
+
 
 
 
@@ -851,8 +8112,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.account_service.ListAccountsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.account_service.ListAccountsResponse.toObject(opt_includeInstance, this);
+proto.account_service.TransferResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.TransferResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -861,17 +8122,15 @@ proto.account_service.ListAccountsResponse.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.account_service.ListAccountsResponse} msg The msg instance to transform.
+ * @param {!proto.account_service.TransferResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.ListAccountsResponse.toObject = function(includeInstance, msg) {
+proto.account_service.TransferResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pageSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    pageNumber: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    totalCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    accountsList: jspb.Message.toObjectList(msg.getAccountsList(),
-    proto.account_service.Account.toObject, includeInstance)
+    transferId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    withdrawalId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    depositId: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -885,23 +8144,23 @@ proto.account_service.ListAccountsResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.account_service.ListAccountsResponse}
+ * @return {!proto.account_service.TransferResponse}
  */
-proto.account_service.ListAccountsResponse.deserializeBinary = function(bytes) {
+proto.account_service.TransferResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.account_service.ListAccountsResponse;
-  return proto.account_service.ListAccountsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.account_service.TransferResponse;
+  return proto.account_service.TransferResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.account_service.ListAccountsResponse} msg The message object to deserialize into.
+ * @param {!proto.account_service.TransferResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.account_service.ListAccountsResponse}
+ * @return {!proto.account_service.TransferResponse}
  */
-proto.account_service.ListAccountsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.account_service.TransferResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -909,21 +8168,16 @@ proto.account_service.ListAccountsResponse.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageSize(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTransferId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageNumber(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWithdrawalId(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setTotalCount(value);
-      break;
-    case 4:
-      var value = new proto.account_service.Account;
-      reader.readMessage(value,proto.account_service.Account.deserializeBinaryFromReader);
-      msg.addAccounts(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setDepositId(value);
       break;
     default:
       reader.skipField();
@@ -938,9 +8192,9 @@ proto.account_service.ListAccountsResponse.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.account_service.ListAccountsResponse.prototype.serializeBinary = function() {
+proto.account_service.TransferResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.account_service.ListAccountsResponse.serializeBinaryToWriter(this, writer);
+  proto.account_service.TransferResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -948,133 +8202,87 @@ proto.account_service.ListAccountsResponse.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.account_service.ListAccountsResponse} message
+ * @param {!proto.account_service.TransferResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.ListAccountsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.account_service.TransferResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPageSize();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getTransferId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getPageNumber();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getWithdrawalId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getTotalCount();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getDepositId();
+  if (f.length > 0) {
+    writer.writeString(
       3,
       f
     );
   }
-  f = message.getAccountsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      proto.account_service.Account.serializeBinaryToWriter
-    );
-  }
-};
-
-
-/**
- * optional int32 page_size = 1;
- * @return {number}
- */
-proto.account_service.ListAccountsResponse.prototype.getPageSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.account_service.ListAccountsResponse} returns this
- */
-proto.account_service.ListAccountsResponse.prototype.setPageSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional int32 page_number = 2;
- * @return {number}
- */
-proto.account_service.ListAccountsResponse.prototype.getPageNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.account_service.ListAccountsResponse} returns this
- */
-proto.account_service.ListAccountsResponse.prototype.setPageNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 total_count = 3;
- * @return {number}
+ * optional string transfer_id = 1;
+ * @return {string}
  */
-proto.account_service.ListAccountsResponse.prototype.getTotalCount = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.account_service.TransferResponse.prototype.getTransferId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.account_service.ListAccountsResponse} returns this
+ * @param {string} value
+ * @return {!proto.account_service.TransferResponse} returns this
  */
-proto.account_service.ListAccountsResponse.prototype.setTotalCount = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.account_service.TransferResponse.prototype.setTransferId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated Account accounts = 4;
- * @return {!Array<!proto.account_service.Account>}
+ * optional string withdrawal_id = 2;
+ * @return {string}
  */
-proto.account_service.ListAccountsResponse.prototype.getAccountsList = function() {
-  return /** @type{!Array<!proto.account_service.Account>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.account_service.Account, 4));
+proto.account_service.TransferResponse.prototype.getWithdrawalId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!Array<!proto.account_service.Account>} value
- * @return {!proto.account_service.ListAccountsResponse} returns this
-*/
-proto.account_service.ListAccountsResponse.prototype.setAccountsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+ * @param {string} value
+ * @return {!proto.account_service.TransferResponse} returns this
+ */
+proto.account_service.TransferResponse.prototype.setWithdrawalId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.account_service.Account=} opt_value
- * @param {number=} opt_index
- * @return {!proto.account_service.Account}
+ * optional string deposit_id = 3;
+ * @return {string}
  */
-proto.account_service.ListAccountsResponse.prototype.addAccounts = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.account_service.Account, opt_index);
+proto.account_service.TransferResponse.prototype.getDepositId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.account_service.ListAccountsResponse} returns this
+ * @param {string} value
+ * @return {!proto.account_service.TransferResponse} returns this
  */
-proto.account_service.ListAccountsResponse.prototype.clearAccountsList = function() {
-  return this.setAccountsList([]);
+proto.account_service.TransferResponse.prototype.setDepositId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -1094,8 +8302,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.account_service.ListTransactionsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.account_service.ListTransactionsRequest.toObject(opt_includeInstance, this);
+proto.account_service.ReverseTransactionRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ReverseTransactionRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -1104,15 +8312,13 @@ proto.account_service.ListTransactionsRequest.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.account_service.ListTransactionsRequest} msg The msg instance to transform.
+ * @param {!proto.account_service.ReverseTransactionRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.ListTransactionsRequest.toObject = function(includeInstance, msg) {
+proto.account_service.ReverseTransactionRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pageSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    pageNumber: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    accountNumber: jspb.Message.getFieldWithDefault(msg, 3, "")
+    transactionId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -1126,23 +8332,23 @@ proto.account_service.ListTransactionsRequest.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.account_service.ListTransactionsRequest}
+ * @return {!proto.account_service.ReverseTransactionRequest}
  */
-proto.account_service.ListTransactionsRequest.deserializeBinary = function(bytes) {
+proto.account_service.ReverseTransactionRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.account_service.ListTransactionsRequest;
-  return proto.account_service.ListTransactionsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.account_service.ReverseTransactionRequest;
+  return proto.account_service.ReverseTransactionRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.account_service.ListTransactionsRequest} msg The message object to deserialize into.
+ * @param {!proto.account_service.ReverseTransactionRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.account_service.ListTransactionsRequest}
+ * @return {!proto.account_service.ReverseTransactionRequest}
  */
-proto.account_service.ListTransactionsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.account_service.ReverseTransactionRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1150,16 +8356,8 @@ proto.account_service.ListTransactionsRequest.deserializeBinaryFromReader = func
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageSize(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageNumber(value);
-      break;
-    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setAccountNumber(value);
+      msg.setTransactionId(value);
       break;
     default:
       reader.skipField();
@@ -1174,9 +8372,9 @@ proto.account_service.ListTransactionsRequest.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.account_service.ListTransactionsRequest.prototype.serializeBinary = function() {
+proto.account_service.ReverseTransactionRequest.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.account_service.ListTransactionsRequest.serializeBinaryToWriter(this, writer);
+  proto.account_service.ReverseTransactionRequest.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1184,30 +8382,16 @@ proto.account_service.ListTransactionsRequest.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.account_service.ListTransactionsRequest} message
+ * @param {!proto.account_service.ReverseTransactionRequest} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.ListTransactionsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.account_service.ReverseTransactionRequest.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPageSize();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
-  f = message.getPageNumber();
-  if (f !== 0) {
-    writer.writeInt32(
-      2,
-      f
-    );
-  }
-  f = message.getAccountNumber();
+  f = message.getTransactionId();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      1,
       f
     );
   }
@@ -1215,67 +8399,24 @@ proto.account_service.ListTransactionsRequest.serializeBinaryToWriter = function
 
 
 /**
- * optional int32 page_size = 1;
- * @return {number}
- */
-proto.account_service.ListTransactionsRequest.prototype.getPageSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.account_service.ListTransactionsRequest} returns this
- */
-proto.account_service.ListTransactionsRequest.prototype.setPageSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
-};
-
-
-/**
- * optional int32 page_number = 2;
- * @return {number}
- */
-proto.account_service.ListTransactionsRequest.prototype.getPageNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.account_service.ListTransactionsRequest} returns this
- */
-proto.account_service.ListTransactionsRequest.prototype.setPageNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string account_number = 3;
+ * optional string transaction_id = 1;
  * @return {string}
  */
-proto.account_service.ListTransactionsRequest.prototype.getAccountNumber = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.account_service.ReverseTransactionRequest.prototype.getTransactionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.account_service.ListTransactionsRequest} returns this
+ * @return {!proto.account_service.ReverseTransactionRequest} returns this
  */
-proto.account_service.ListTransactionsRequest.prototype.setAccountNumber = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.account_service.ReverseTransactionRequest.prototype.setTransactionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.account_service.ListTransactionsResponse.repeatedFields_ = [4];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1291,8 +8432,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.account_service.ListTransactionsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.account_service.ListTransactionsResponse.toObject(opt_includeInstance, this);
+proto.account_service.ReverseTransactionResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.ReverseTransactionResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1301,17 +8442,14 @@ proto.account_service.ListTransactionsResponse.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.account_service.ListTransactionsResponse} msg The msg instance to transform.
+ * @param {!proto.account_service.ReverseTransactionResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.ListTransactionsResponse.toObject = function(includeInstance, msg) {
+proto.account_service.ReverseTransactionResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    pageSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    pageNumber: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    totalCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    transactionsList: jspb.Message.toObjectList(msg.getTransactionsList(),
-    proto.account_service.Transaction.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    transferId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1325,23 +8463,23 @@ proto.account_service.ListTransactionsResponse.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.account_service.ListTransactionsResponse}
+ * @return {!proto.account_service.ReverseTransactionResponse}
  */
-proto.account_service.ListTransactionsResponse.deserializeBinary = function(bytes) {
+proto.account_service.ReverseTransactionResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.account_service.ListTransactionsResponse;
-  return proto.account_service.ListTransactionsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.account_service.ReverseTransactionResponse;
+  return proto.account_service.ReverseTransactionResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.account_service.ListTransactionsResponse} msg The message object to deserialize into.
+ * @param {!proto.account_service.ReverseTransactionResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.account_service.ListTransactionsResponse}
+ * @return {!proto.account_service.ReverseTransactionResponse}
  */
-proto.account_service.ListTransactionsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.account_service.ReverseTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1349,21 +8487,12 @@ proto.account_service.ListTransactionsResponse.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageSize(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setPageNumber(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setTotalCount(value);
-      break;
-    case 4:
-      var value = new proto.account_service.Transaction;
-      reader.readMessage(value,proto.account_service.Transaction.deserializeBinaryFromReader);
-      msg.addTransactions(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTransferId(value);
       break;
     default:
       reader.skipField();
@@ -1378,9 +8507,9 @@ proto.account_service.ListTransactionsResponse.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.account_service.ListTransactionsResponse.prototype.serializeBinary = function() {
+proto.account_service.ReverseTransactionResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.account_service.ListTransactionsResponse.serializeBinaryToWriter(this, writer);
+  proto.account_service.ReverseTransactionResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1388,133 +8517,192 @@ proto.account_service.ListTransactionsResponse.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.account_service.ListTransactionsResponse} message
+ * @param {!proto.account_service.ReverseTransactionResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.ListTransactionsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.account_service.ReverseTransactionResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPageSize();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getPageNumber();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = message.getTransferId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getTotalCount();
-  if (f !== 0) {
-    writer.writeInt32(
-      3,
-      f
-    );
-  }
-  f = message.getTransactionsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      4,
-      f,
-      proto.account_service.Transaction.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * optional int32 page_size = 1;
- * @return {number}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.account_service.ListTransactionsResponse.prototype.getPageSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.account_service.ReverseTransactionResponse.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.account_service.ListTransactionsResponse} returns this
+ * @param {string} value
+ * @return {!proto.account_service.ReverseTransactionResponse} returns this
  */
-proto.account_service.ListTransactionsResponse.prototype.setPageSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.account_service.ReverseTransactionResponse.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 page_number = 2;
- * @return {number}
+ * optional string transfer_id = 2;
+ * @return {string}
  */
-proto.account_service.ListTransactionsResponse.prototype.getPageNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.account_service.ReverseTransactionResponse.prototype.getTransferId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.account_service.ListTransactionsResponse} returns this
+ * @param {string} value
+ * @return {!proto.account_service.ReverseTransactionResponse} returns this
  */
-proto.account_service.ListTransactionsResponse.prototype.setPageNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.account_service.ReverseTransactionResponse.prototype.setTransferId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional int32 total_count = 3;
- * @return {number}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.account_service.ListTransactionsResponse.prototype.getTotalCount = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.account_service.GetTransactionProofRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetTransactionProofRequest.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.account_service.GetTransactionProofRequest} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.account_service.GetTransactionProofRequest.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    transactionId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.account_service.GetTransactionProofRequest}
+ */
+proto.account_service.GetTransactionProofRequest.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.account_service.GetTransactionProofRequest;
+  return proto.account_service.GetTransactionProofRequest.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.account_service.GetTransactionProofRequest} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.account_service.GetTransactionProofRequest}
+ */
+proto.account_service.GetTransactionProofRequest.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTransactionId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.account_service.ListTransactionsResponse} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.account_service.ListTransactionsResponse.prototype.setTotalCount = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.account_service.GetTransactionProofRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.account_service.GetTransactionProofRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * repeated Transaction transactions = 4;
- * @return {!Array<!proto.account_service.Transaction>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.account_service.GetTransactionProofRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.ListTransactionsResponse.prototype.getTransactionsList = function() {
-  return /** @type{!Array<!proto.account_service.Transaction>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.account_service.Transaction, 4));
-};
-
-
-/**
- * @param {!Array<!proto.account_service.Transaction>} value
- * @return {!proto.account_service.ListTransactionsResponse} returns this
-*/
-proto.account_service.ListTransactionsResponse.prototype.setTransactionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+proto.account_service.GetTransactionProofRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTransactionId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!proto.account_service.Transaction=} opt_value
- * @param {number=} opt_index
- * @return {!proto.account_service.Transaction}
+ * optional string transaction_id = 1;
+ * @return {string}
  */
-proto.account_service.ListTransactionsResponse.prototype.addTransactions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.account_service.Transaction, opt_index);
+proto.account_service.GetTransactionProofRequest.prototype.getTransactionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.account_service.ListTransactionsResponse} returns this
+ * @param {string} value
+ * @return {!proto.account_service.GetTransactionProofRequest} returns this
  */
-proto.account_service.ListTransactionsResponse.prototype.clearTransactionsList = function() {
-  return this.setTransactionsList([]);
+proto.account_service.GetTransactionProofRequest.prototype.setTransactionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -1534,8 +8722,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.account_service.CreateAccountResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.account_service.CreateAccountResponse.toObject(opt_includeInstance, this);
+proto.account_service.LedgerState.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.LedgerState.toObject(opt_includeInstance, this);
 };
 
 
@@ -1544,13 +8732,15 @@ proto.account_service.CreateAccountResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.account_service.CreateAccountResponse} msg The msg instance to transform.
+ * @param {!proto.account_service.LedgerState} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.CreateAccountResponse.toObject = function(includeInstance, msg) {
+proto.account_service.LedgerState.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    db: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    txId: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    txHash: msg.getTxHash_asB64()
   };
 
   if (includeInstance) {
@@ -1564,23 +8754,23 @@ proto.account_service.CreateAccountResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.account_service.CreateAccountResponse}
+ * @return {!proto.account_service.LedgerState}
  */
-proto.account_service.CreateAccountResponse.deserializeBinary = function(bytes) {
+proto.account_service.LedgerState.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.account_service.CreateAccountResponse;
-  return proto.account_service.CreateAccountResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.account_service.LedgerState;
+  return proto.account_service.LedgerState.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.account_service.CreateAccountResponse} msg The message object to deserialize into.
+ * @param {!proto.account_service.LedgerState} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.account_service.CreateAccountResponse}
+ * @return {!proto.account_service.LedgerState}
  */
-proto.account_service.CreateAccountResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.account_service.LedgerState.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1589,7 +8779,15 @@ proto.account_service.CreateAccountResponse.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      msg.setDb(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTxId(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setTxHash(value);
       break;
     default:
       reader.skipField();
@@ -1604,9 +8802,9 @@ proto.account_service.CreateAccountResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.account_service.CreateAccountResponse.prototype.serializeBinary = function() {
+proto.account_service.LedgerState.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.account_service.CreateAccountResponse.serializeBinaryToWriter(this, writer);
+  proto.account_service.LedgerState.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1614,40 +8812,114 @@ proto.account_service.CreateAccountResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.account_service.CreateAccountResponse} message
+ * @param {!proto.account_service.LedgerState} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.CreateAccountResponse.serializeBinaryToWriter = function(message, writer) {
+proto.account_service.LedgerState.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
+  f = message.getDb();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getTxId();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
+  f = message.getTxHash_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional string db = 1;
  * @return {string}
  */
-proto.account_service.CreateAccountResponse.prototype.getId = function() {
+proto.account_service.LedgerState.prototype.getDb = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.account_service.CreateAccountResponse} returns this
+ * @return {!proto.account_service.LedgerState} returns this
  */
-proto.account_service.CreateAccountResponse.prototype.setId = function(value) {
+proto.account_service.LedgerState.prototype.setDb = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional uint64 tx_id = 2;
+ * @return {number}
+ */
+proto.account_service.LedgerState.prototype.getTxId = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.account_service.LedgerState} returns this
+ */
+proto.account_service.LedgerState.prototype.setTxId = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional bytes tx_hash = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.account_service.LedgerState.prototype.getTxHash = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes tx_hash = 3;
+ * This is a type-conversion wrapper around `getTxHash()`
+ * @return {string}
+ */
+proto.account_service.LedgerState.prototype.getTxHash_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getTxHash()));
+};
+
+
+/**
+ * optional bytes tx_hash = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getTxHash()`
+ * @return {!Uint8Array}
+ */
+proto.account_service.LedgerState.prototype.getTxHash_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getTxHash()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.account_service.LedgerState} returns this
+ */
+proto.account_service.LedgerState.prototype.setTxHash = function(value) {
+  return jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
 
 
 
@@ -1664,8 +8936,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.account_service.CreateTransactionResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.account_service.CreateTransactionResponse.toObject(opt_includeInstance, this);
+proto.account_service.GetTransactionProofResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.account_service.GetTransactionProofResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -1674,13 +8946,16 @@ proto.account_service.CreateTransactionResponse.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.account_service.CreateTransactionResponse} msg The msg instance to transform.
+ * @param {!proto.account_service.GetTransactionProofResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.CreateTransactionResponse.toObject = function(includeInstance, msg) {
+proto.account_service.GetTransactionProofResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    id: jspb.Message.getFieldWithDefault(msg, 1, "")
+    transaction: (f = msg.getTransaction()) && proto.account_service.Transaction.toObject(includeInstance, f),
+    documentProof: msg.getDocumentProof_asB64(),
+    trustedState: (f = msg.getTrustedState()) && proto.account_service.LedgerState.toObject(includeInstance, f),
+    state: (f = msg.getState()) && proto.account_service.LedgerState.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -1694,23 +8969,23 @@ proto.account_service.CreateTransactionResponse.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.account_service.CreateTransactionResponse}
+ * @return {!proto.account_service.GetTransactionProofResponse}
  */
-proto.account_service.CreateTransactionResponse.deserializeBinary = function(bytes) {
+proto.account_service.GetTransactionProofResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.account_service.CreateTransactionResponse;
-  return proto.account_service.CreateTransactionResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.account_service.GetTransactionProofResponse;
+  return proto.account_service.GetTransactionProofResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.account_service.CreateTransactionResponse} msg The message object to deserialize into.
+ * @param {!proto.account_service.GetTransactionProofResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.account_service.CreateTransactionResponse}
+ * @return {!proto.account_service.GetTransactionProofResponse}
  */
-proto.account_service.CreateTransactionResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.account_service.GetTransactionProofResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1718,8 +8993,23 @@ proto.account_service.CreateTransactionResponse.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setId(value);
+      var value = new proto.account_service.Transaction;
+      reader.readMessage(value,proto.account_service.Transaction.deserializeBinaryFromReader);
+      msg.setTransaction(value);
+      break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setDocumentProof(value);
+      break;
+    case 3:
+      var value = new proto.account_service.LedgerState;
+      reader.readMessage(value,proto.account_service.LedgerState.deserializeBinaryFromReader);
+      msg.setTrustedState(value);
+      break;
+    case 4:
+      var value = new proto.account_service.LedgerState;
+      reader.readMessage(value,proto.account_service.LedgerState.deserializeBinaryFromReader);
+      msg.setState(value);
       break;
     default:
       reader.skipField();
@@ -1734,9 +9024,9 @@ proto.account_service.CreateTransactionResponse.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.account_service.CreateTransactionResponse.prototype.serializeBinary = function() {
+proto.account_service.GetTransactionProofResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.account_service.CreateTransactionResponse.serializeBinaryToWriter(this, writer);
+  proto.account_service.GetTransactionProofResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1744,37 +9034,196 @@ proto.account_service.CreateTransactionResponse.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.account_service.CreateTransactionResponse} message
+ * @param {!proto.account_service.GetTransactionProofResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.account_service.CreateTransactionResponse.serializeBinaryToWriter = function(message, writer) {
+proto.account_service.GetTransactionProofResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getId();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getTransaction();
+  if (f != null) {
+    writer.writeMessage(
       1,
+      f,
+      proto.account_service.Transaction.serializeBinaryToWriter
+    );
+  }
+  f = message.getDocumentProof_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
       f
     );
   }
+  f = message.getTrustedState();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.account_service.LedgerState.serializeBinaryToWriter
+    );
+  }
+  f = message.getState();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.account_service.LedgerState.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional string id = 1;
+ * optional Transaction transaction = 1;
+ * @return {?proto.account_service.Transaction}
+ */
+proto.account_service.GetTransactionProofResponse.prototype.getTransaction = function() {
+  return /** @type{?proto.account_service.Transaction} */ (
+    jspb.Message.getWrapperField(this, proto.account_service.Transaction, 1));
+};
+
+
+/**
+ * @param {?proto.account_service.Transaction|undefined} value
+ * @return {!proto.account_service.GetTransactionProofResponse} returns this
+*/
+proto.account_service.GetTransactionProofResponse.prototype.setTransaction = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.GetTransactionProofResponse} returns this
+ */
+proto.account_service.GetTransactionProofResponse.prototype.clearTransaction = function() {
+  return this.setTransaction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.GetTransactionProofResponse.prototype.hasTransaction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional bytes document_proof = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.account_service.GetTransactionProofResponse.prototype.getDocumentProof = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes document_proof = 2;
+ * This is a type-conversion wrapper around `getDocumentProof()`
  * @return {string}
  */
-proto.account_service.CreateTransactionResponse.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.account_service.GetTransactionProofResponse.prototype.getDocumentProof_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getDocumentProof()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.account_service.CreateTransactionResponse} returns this
+ * optional bytes document_proof = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getDocumentProof()`
+ * @return {!Uint8Array}
  */
-proto.account_service.CreateTransactionResponse.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.account_service.GetTransactionProofResponse.prototype.getDocumentProof_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getDocumentProof()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.account_service.GetTransactionProofResponse} returns this
+ */
+proto.account_service.GetTransactionProofResponse.prototype.setDocumentProof = function(value) {
+  return jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
+/**
+ * optional LedgerState trusted_state = 3;
+ * @return {?proto.account_service.LedgerState}
+ */
+proto.account_service.GetTransactionProofResponse.prototype.getTrustedState = function() {
+  return /** @type{?proto.account_service.LedgerState} */ (
+    jspb.Message.getWrapperField(this, proto.account_service.LedgerState, 3));
+};
+
+
+/**
+ * @param {?proto.account_service.LedgerState|undefined} value
+ * @return {!proto.account_service.GetTransactionProofResponse} returns this
+*/
+proto.account_service.GetTransactionProofResponse.prototype.setTrustedState = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.GetTransactionProofResponse} returns this
+ */
+proto.account_service.GetTransactionProofResponse.prototype.clearTrustedState = function() {
+  return this.setTrustedState(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.GetTransactionProofResponse.prototype.hasTrustedState = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional LedgerState state = 4;
+ * @return {?proto.account_service.LedgerState}
+ */
+proto.account_service.GetTransactionProofResponse.prototype.getState = function() {
+  return /** @type{?proto.account_service.LedgerState} */ (
+    jspb.Message.getWrapperField(this, proto.account_service.LedgerState, 4));
+};
+
+
+/**
+ * @param {?proto.account_service.LedgerState|undefined} value
+ * @return {!proto.account_service.GetTransactionProofResponse} returns this
+*/
+proto.account_service.GetTransactionProofResponse.prototype.setState = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.account_service.GetTransactionProofResponse} returns this
+ */
+proto.account_service.GetTransactionProofResponse.prototype.clearState = function() {
+  return this.setState(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.account_service.GetTransactionProofResponse.prototype.hasState = function() {
+  return jspb.Message.getField(this, 4) != null;
 };
 
 
@@ -1786,4 +9235,25 @@ proto.account_service.TransactionType = {
   WITHDRAWAL: 1
 };
 
+/**
+ * @enum {number}
+ */
+proto.account_service.FilterOperator = {
+  EQ: 0,
+  NE: 1,
+  LT: 2,
+  LE: 3,
+  GT: 4,
+  GE: 5,
+  LIKE: 6
+};
+
+/**
+ * @enum {number}
+ */
+proto.account_service.StatementFormat = {
+  CAMT_053: 0,
+  MT940: 1
+};
+
 goog.object.extend(exports, proto.account_service);
@@ -13,6 +13,15 @@ type AccountServiceListAccounts = {
   readonly responseType: typeof proto_accountservice_pb.ListAccountsResponse;
 };
 
+type AccountServiceGetAccount = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.GetAccountRequest;
+  readonly responseType: typeof proto_accountservice_pb.GetAccountResponse;
+};
+
 type AccountServiceListTransactions = {
   readonly methodName: string;
   readonly service: typeof AccountService;
@@ -22,6 +31,24 @@ type AccountServiceListTransactions = {
   readonly responseType: typeof proto_accountservice_pb.ListTransactionsResponse;
 };
 
+type AccountServiceExportTransactions = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof proto_accountservice_pb.ExportTransactionsRequest;
+  readonly responseType: typeof proto_accountservice_pb.Transaction;
+};
+
+type AccountServiceWatchTransactions = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: true;
+  readonly requestType: typeof proto_accountservice_pb.WatchTransactionsRequest;
+  readonly responseType: typeof proto_accountservice_pb.Transaction;
+};
+
 type AccountServiceCreateAccount = {
   readonly methodName: string;
   readonly service: typeof AccountService;
@@ -31,6 +58,24 @@ type AccountServiceCreateAccount = {
   readonly responseType: typeof proto_accountservice_pb.CreateAccountResponse;
 };
 
+type AccountServiceUpdateAccount = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.UpdateAccountRequest;
+  readonly responseType: typeof proto_accountservice_pb.UpdateAccountResponse;
+};
+
+type AccountServiceGetAccountHistory = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.GetAccountHistoryRequest;
+  readonly responseType: typeof proto_accountservice_pb.GetAccountHistoryResponse;
+};
+
 type AccountServiceCreateTransaction = {
   readonly methodName: string;
   readonly service: typeof AccountService;
@@ -40,12 +85,87 @@ type AccountServiceCreateTransaction = {
   readonly responseType: typeof proto_accountservice_pb.CreateTransactionResponse;
 };
 
+type AccountServiceCreateTransactions = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.CreateTransactionsRequest;
+  readonly responseType: typeof proto_accountservice_pb.CreateTransactionsResponse;
+};
+
+type AccountServiceGetAccountBalance = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.GetAccountBalanceRequest;
+  readonly responseType: typeof proto_accountservice_pb.GetAccountBalanceResponse;
+};
+
+type AccountServiceGetStatement = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.GetStatementRequest;
+  readonly responseType: typeof proto_accountservice_pb.GetStatementResponse;
+};
+
+type AccountServiceExportStatement = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.ExportStatementRequest;
+  readonly responseType: typeof proto_accountservice_pb.ExportStatementResponse;
+};
+
+type AccountServiceTransfer = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.TransferRequest;
+  readonly responseType: typeof proto_accountservice_pb.TransferResponse;
+};
+
+type AccountServiceReverseTransaction = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.ReverseTransactionRequest;
+  readonly responseType: typeof proto_accountservice_pb.ReverseTransactionResponse;
+};
+
+type AccountServiceGetTransactionProof = {
+  readonly methodName: string;
+  readonly service: typeof AccountService;
+  readonly requestStream: false;
+  readonly responseStream: false;
+  readonly requestType: typeof proto_accountservice_pb.GetTransactionProofRequest;
+  readonly responseType: typeof proto_accountservice_pb.GetTransactionProofResponse;
+};
+
 export class AccountService {
   static readonly serviceName: string;
   static readonly ListAccounts: AccountServiceListAccounts;
+  static readonly GetAccount: AccountServiceGetAccount;
   static readonly ListTransactions: AccountServiceListTransactions;
+  static readonly ExportTransactions: AccountServiceExportTransactions;
+  static readonly WatchTransactions: AccountServiceWatchTransactions;
   static readonly CreateAccount: AccountServiceCreateAccount;
+  static readonly UpdateAccount: AccountServiceUpdateAccount;
+  static readonly GetAccountHistory: AccountServiceGetAccountHistory;
   static readonly CreateTransaction: AccountServiceCreateTransaction;
+  static readonly CreateTransactions: AccountServiceCreateTransactions;
+  static readonly GetAccountBalance: AccountServiceGetAccountBalance;
+  static readonly GetStatement: AccountServiceGetStatement;
+  static readonly ExportStatement: AccountServiceExportStatement;
+  static readonly Transfer: AccountServiceTransfer;
+  static readonly ReverseTransaction: AccountServiceReverseTransaction;
+  static readonly GetTransactionProof: AccountServiceGetTransactionProof;
 }
 
 export type ServiceError = { message: string, code: number; metadata: grpc.Metadata }
@@ -89,6 +209,15 @@ export class AccountServiceClient {
     requestMessage: proto_accountservice_pb.ListAccountsRequest,
     callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.ListAccountsResponse|null) => void
   ): UnaryResponse;
+  getAccount(
+    requestMessage: proto_accountservice_pb.GetAccountRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetAccountResponse|null) => void
+  ): UnaryResponse;
+  getAccount(
+    requestMessage: proto_accountservice_pb.GetAccountRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetAccountResponse|null) => void
+  ): UnaryResponse;
   listTransactions(
     requestMessage: proto_accountservice_pb.ListTransactionsRequest,
     metadata: grpc.Metadata,
@@ -98,6 +227,8 @@ export class AccountServiceClient {
     requestMessage: proto_accountservice_pb.ListTransactionsRequest,
     callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.ListTransactionsResponse|null) => void
   ): UnaryResponse;
+  exportTransactions(requestMessage: proto_accountservice_pb.ExportTransactionsRequest, metadata?: grpc.Metadata): ResponseStream<proto_accountservice_pb.Transaction>;
+  watchTransactions(requestMessage: proto_accountservice_pb.WatchTransactionsRequest, metadata?: grpc.Metadata): ResponseStream<proto_accountservice_pb.Transaction>;
   createAccount(
     requestMessage: proto_accountservice_pb.Account,
     metadata: grpc.Metadata,
@@ -107,6 +238,24 @@ export class AccountServiceClient {
     requestMessage: proto_accountservice_pb.Account,
     callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.CreateAccountResponse|null) => void
   ): UnaryResponse;
+  updateAccount(
+    requestMessage: proto_accountservice_pb.UpdateAccountRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.UpdateAccountResponse|null) => void
+  ): UnaryResponse;
+  updateAccount(
+    requestMessage: proto_accountservice_pb.UpdateAccountRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.UpdateAccountResponse|null) => void
+  ): UnaryResponse;
+  getAccountHistory(
+    requestMessage: proto_accountservice_pb.GetAccountHistoryRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetAccountHistoryResponse|null) => void
+  ): UnaryResponse;
+  getAccountHistory(
+    requestMessage: proto_accountservice_pb.GetAccountHistoryRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetAccountHistoryResponse|null) => void
+  ): UnaryResponse;
   createTransaction(
     requestMessage: proto_accountservice_pb.Transaction,
     metadata: grpc.Metadata,
@@ -116,5 +265,68 @@ export class AccountServiceClient {
     requestMessage: proto_accountservice_pb.Transaction,
     callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.CreateTransactionResponse|null) => void
   ): UnaryResponse;
+  createTransactions(
+    requestMessage: proto_accountservice_pb.CreateTransactionsRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.CreateTransactionsResponse|null) => void
+  ): UnaryResponse;
+  createTransactions(
+    requestMessage: proto_accountservice_pb.CreateTransactionsRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.CreateTransactionsResponse|null) => void
+  ): UnaryResponse;
+  getAccountBalance(
+    requestMessage: proto_accountservice_pb.GetAccountBalanceRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetAccountBalanceResponse|null) => void
+  ): UnaryResponse;
+  getAccountBalance(
+    requestMessage: proto_accountservice_pb.GetAccountBalanceRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetAccountBalanceResponse|null) => void
+  ): UnaryResponse;
+  getStatement(
+    requestMessage: proto_accountservice_pb.GetStatementRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetStatementResponse|null) => void
+  ): UnaryResponse;
+  getStatement(
+    requestMessage: proto_accountservice_pb.GetStatementRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetStatementResponse|null) => void
+  ): UnaryResponse;
+  exportStatement(
+    requestMessage: proto_accountservice_pb.ExportStatementRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.ExportStatementResponse|null) => void
+  ): UnaryResponse;
+  exportStatement(
+    requestMessage: proto_accountservice_pb.ExportStatementRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.ExportStatementResponse|null) => void
+  ): UnaryResponse;
+  transfer(
+    requestMessage: proto_accountservice_pb.TransferRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.TransferResponse|null) => void
+  ): UnaryResponse;
+  transfer(
+    requestMessage: proto_accountservice_pb.TransferRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.TransferResponse|null) => void
+  ): UnaryResponse;
+  reverseTransaction(
+    requestMessage: proto_accountservice_pb.ReverseTransactionRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.ReverseTransactionResponse|null) => void
+  ): UnaryResponse;
+  reverseTransaction(
+    requestMessage: proto_accountservice_pb.ReverseTransactionRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.ReverseTransactionResponse|null) => void
+  ): UnaryResponse;
+  getTransactionProof(
+    requestMessage: proto_accountservice_pb.GetTransactionProofRequest,
+    metadata: grpc.Metadata,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetTransactionProofResponse|null) => void
+  ): UnaryResponse;
+  getTransactionProof(
+    requestMessage: proto_accountservice_pb.GetTransactionProofRequest,
+    callback: (error: ServiceError|null, responseMessage: proto_accountservice_pb.GetTransactionProofResponse|null) => void
+  ): UnaryResponse;
 }
 
@@ -19,6 +19,15 @@ AccountService.ListAccounts = {
   responseType: proto_accountservice_pb.ListAccountsResponse
 };
 
+AccountService.GetAccount = {
+  methodName: "GetAccount",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.GetAccountRequest,
+  responseType: proto_accountservice_pb.GetAccountResponse
+};
+
 AccountService.ListTransactions = {
   methodName: "ListTransactions",
   service: AccountService,
@@ -28,6 +37,24 @@ AccountService.ListTransactions = {
   responseType: proto_accountservice_pb.ListTransactionsResponse
 };
 
+AccountService.ExportTransactions = {
+  methodName: "ExportTransactions",
+  service: AccountService,
+  requestStream: false,
+  responseStream: true,
+  requestType: proto_accountservice_pb.ExportTransactionsRequest,
+  responseType: proto_accountservice_pb.Transaction
+};
+
+AccountService.WatchTransactions = {
+  methodName: "WatchTransactions",
+  service: AccountService,
+  requestStream: false,
+  responseStream: true,
+  requestType: proto_accountservice_pb.WatchTransactionsRequest,
+  responseType: proto_accountservice_pb.Transaction
+};
+
 AccountService.CreateAccount = {
   methodName: "CreateAccount",
   service: AccountService,
@@ -37,6 +64,24 @@ AccountService.CreateAccount = {
   responseType: proto_accountservice_pb.CreateAccountResponse
 };
 
+AccountService.UpdateAccount = {
+  methodName: "UpdateAccount",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.UpdateAccountRequest,
+  responseType: proto_accountservice_pb.UpdateAccountResponse
+};
+
+AccountService.GetAccountHistory = {
+  methodName: "GetAccountHistory",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.GetAccountHistoryRequest,
+  responseType: proto_accountservice_pb.GetAccountHistoryResponse
+};
+
 AccountService.CreateTransaction = {
   methodName: "CreateTransaction",
   service: AccountService,
@@ -46,6 +91,69 @@ AccountService.CreateTransaction = {
   responseType: proto_accountservice_pb.CreateTransactionResponse
 };
 
+AccountService.CreateTransactions = {
+  methodName: "CreateTransactions",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.CreateTransactionsRequest,
+  responseType: proto_accountservice_pb.CreateTransactionsResponse
+};
+
+AccountService.GetAccountBalance = {
+  methodName: "GetAccountBalance",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.GetAccountBalanceRequest,
+  responseType: proto_accountservice_pb.GetAccountBalanceResponse
+};
+
+AccountService.GetStatement = {
+  methodName: "GetStatement",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.GetStatementRequest,
+  responseType: proto_accountservice_pb.GetStatementResponse
+};
+
+AccountService.ExportStatement = {
+  methodName: "ExportStatement",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.ExportStatementRequest,
+  responseType: proto_accountservice_pb.ExportStatementResponse
+};
+
+AccountService.Transfer = {
+  methodName: "Transfer",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.TransferRequest,
+  responseType: proto_accountservice_pb.TransferResponse
+};
+
+AccountService.ReverseTransaction = {
+  methodName: "ReverseTransaction",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.ReverseTransactionRequest,
+  responseType: proto_accountservice_pb.ReverseTransactionResponse
+};
+
+AccountService.GetTransactionProof = {
+  methodName: "GetTransactionProof",
+  service: AccountService,
+  requestStream: false,
+  responseStream: false,
+  requestType: proto_accountservice_pb.GetTransactionProofRequest,
+  responseType: proto_accountservice_pb.GetTransactionProofResponse
+};
+
 exports.AccountService = AccountService;
 
 function AccountServiceClient(serviceHost, options) {
@@ -84,6 +192,37 @@ AccountServiceClient.prototype.listAccounts = function listAccounts(requestMessa
   };
 };
 
+AccountServiceClient.prototype.getAccount = function getAccount(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.GetAccount, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 AccountServiceClient.prototype.listTransactions = function listTransactions(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -115,6 +254,84 @@ AccountServiceClient.prototype.listTransactions = function listTransactions(requ
   };
 };
 
+AccountServiceClient.prototype.exportTransactions = function exportTransactions(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(AccountService.ExportTransactions, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
+AccountServiceClient.prototype.watchTransactions = function watchTransactions(requestMessage, metadata) {
+  var listeners = {
+    data: [],
+    end: [],
+    status: []
+  };
+  var client = grpc.invoke(AccountService.WatchTransactions, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onMessage: function (responseMessage) {
+      listeners.data.forEach(function (handler) {
+        handler(responseMessage);
+      });
+    },
+    onEnd: function (status, statusMessage, trailers) {
+      listeners.status.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners.end.forEach(function (handler) {
+        handler({ code: status, details: statusMessage, metadata: trailers });
+      });
+      listeners = null;
+    }
+  });
+  return {
+    on: function (type, handler) {
+      listeners[type].push(handler);
+      return this;
+    },
+    cancel: function () {
+      listeners = null;
+      client.close();
+    }
+  };
+};
+
 AccountServiceClient.prototype.createAccount = function createAccount(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -146,6 +363,68 @@ AccountServiceClient.prototype.createAccount = function createAccount(requestMes
   };
 };
 
+AccountServiceClient.prototype.updateAccount = function updateAccount(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.UpdateAccount, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+AccountServiceClient.prototype.getAccountHistory = function getAccountHistory(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.GetAccountHistory, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 AccountServiceClient.prototype.createTransaction = function createTransaction(requestMessage, metadata, callback) {
   if (arguments.length === 2) {
     callback = arguments[1];
@@ -177,5 +456,222 @@ AccountServiceClient.prototype.createTransaction = function createTransaction(re
   };
 };
 
+AccountServiceClient.prototype.createTransactions = function createTransactions(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.CreateTransactions, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+AccountServiceClient.prototype.getAccountBalance = function getAccountBalance(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.GetAccountBalance, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+AccountServiceClient.prototype.getStatement = function getStatement(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.GetStatement, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+AccountServiceClient.prototype.exportStatement = function exportStatement(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.ExportStatement, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+AccountServiceClient.prototype.transfer = function transfer(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.Transfer, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+AccountServiceClient.prototype.reverseTransaction = function reverseTransaction(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.ReverseTransaction, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
+AccountServiceClient.prototype.getTransactionProof = function getTransactionProof(requestMessage, metadata, callback) {
+  if (arguments.length === 2) {
+    callback = arguments[1];
+  }
+  var client = grpc.unary(AccountService.GetTransactionProof, {
+    request: requestMessage,
+    host: this.serviceHost,
+    metadata: metadata,
+    transport: this.options.transport,
+    debug: this.options.debug,
+    onEnd: function (response) {
+      if (callback) {
+        if (response.status !== grpc.Code.OK) {
+          var err = new Error(response.statusMessage);
+          err.code = response.status;
+          err.metadata = response.trailers;
+          callback(err, null);
+        } else {
+          callback(null, response.message);
+        }
+      }
+    }
+  });
+  return {
+    cancel: function () {
+      callback = null;
+      client.close();
+    }
+  };
+};
+
 exports.AccountServiceClient = AccountServiceClient;
 